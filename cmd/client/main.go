@@ -2,19 +2,30 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"slices"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 
+	"library-management-service/internal/config"
 	pb "library-management-service/proto/library/v1"
 )
 
 func main() {
+	// The server is found through the same settings as the REST gateway uses
+	cfg, err := config.Load(flag.NewFlagSet("client", flag.ExitOnError), os.Args[1:], os.LookupEnv)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
 	// Connect to the server
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(cfg.GRPC.Target, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
@@ -40,7 +51,6 @@ func main() {
 		log.Fatalf("RegisterUser failed: %v", err)
 	}
 	fmt.Printf("User registered successfully! ID: %s\n", userResp.User.Id)
-	userID := userResp.User.Id
 
 	// 2. Login with the user
 	fmt.Println("\n[2] Logging in...")
@@ -51,26 +61,69 @@ func main() {
 	if err != nil {
 		log.Fatalf("LoginUser failed: %v", err)
 	}
-	fmt.Printf("Login successful! Token: %s\n", loginResp.Token)
-
-	// 3. Create a First book
-	fmt.Println("\n[3] Creating a book...")
-	bookResp, err := client.CreateBook(ctx, &pb.CreateBookRequest{
-		Book: &pb.Book{
-			Title:     "The Test Book3",
-			Author:    "Test Author",
-			Isbn:      "1234567893",
-			Available: true,
-		},
-	})
-	if err != nil {
-		log.Fatalf("CreateBook failed: %v", err)
+	fmt.Printf("Login successful! Token: %s (expires %s)\n", loginResp.Token, loginResp.ExpiresAt)
+
+	// Every other call must carry the access token
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+loginResp.Token)
+
+	// 3. Create a book. Cataloguing needs the librarian or admin role, which new users do not
+	// have; the first admin is made with "server grant-admin <email>".
+	var bookID string
+	if slices.Contains(loginResp.User.Roles, pb.Role_ROLE_LIBRARIAN) || slices.Contains(loginResp.User.Roles, pb.Role_ROLE_ADMIN) {
+		fmt.Println("\n[3] Creating a book...")
+		bookResp, err := client.CreateBook(ctx, &pb.CreateBookRequest{
+			Book: &pb.Book{
+				Title:  "The Test Book3",
+				Author: "Test Author",
+				Isbn:   "1234567893",
+			},
+			Copies: []*pb.BookCopy{{}, {}},
+		})
+		if err != nil {
+			log.Fatalf("CreateBook failed: %v", err)
+		}
+		fmt.Printf("Book created! ID: %s, Copies: %d\n", bookResp.Book.Id, len(bookResp.Copies))
+		bookID = bookResp.Book.Id
+	} else {
+		fmt.Println("\n[3] Skipping book creation: the user is not a librarian or admin")
 	}
-	fmt.Printf("Book created! ID: %s\n", bookResp.Book.Id)
-	bookID := bookResp.Book.Id
 
-	// 4. Get the book
-	fmt.Println("\n[4] Getting book details...")
+	// 4. List all books, a page at a time. Without a book of our own, the first available one
+	// is borrowed.
+	fmt.Println("\n[4] Listing all books...")
+	listReq := &pb.ListBooksRequest{
+		PageSize:          10,
+		IncludeTotalCount: true,
+	}
+	listed := 0
+	for {
+		listResp, err := client.ListBooks(ctx, listReq)
+		if err != nil {
+			log.Fatalf("ListBooks failed: %v", err)
+		}
+		if listed == 0 {
+			fmt.Printf("Found %d books:\n", listResp.TotalCount)
+		}
+		for _, book := range listResp.Books {
+			listed++
+			fmt.Printf("  %d. %s by %s (ID: %s)\n", listed, book.Title, book.Author, book.Id)
+			if bookID == "" && book.Available {
+				bookID = book.Id
+			}
+		}
+		if listResp.NextPageToken == "" {
+			break
+		}
+		listReq.PageToken = listResp.NextPageToken
+		listReq.IncludeTotalCount = false
+	}
+	if bookID == "" {
+		fmt.Println("\nNo book is available to borrow; done.")
+		return
+	}
+
+	// 5. Get the book
+	fmt.Println("\n[5] Getting book details...")
 	getBookResp, err := client.GetBook(ctx, &pb.GetBookRequest{
 		Id: bookID,
 	})
@@ -82,32 +135,23 @@ func main() {
 		getBookResp.Book.Author,
 		getBookResp.Book.Available)
 
-	// 5. List all books
-	fmt.Println("\n[5] Listing all books...")
-	listResp, err := client.ListBooks(ctx, &pb.ListBooksRequest{
-		PageSize: 10,
-	})
-	if err != nil {
-		log.Fatalf("ListBooks failed: %v", err)
-	}
-	fmt.Printf("Found %d books:\n", len(listResp.Books))
-	for i, book := range listResp.Books {
-		fmt.Printf("  %d. %s by %s (ID: %s)\n", i+1, book.Title, book.Author, book.Id)
+	// Borrowing needs a verified email; the token is mailed on registration
+	if !loginResp.User.EmailVerified {
+		fmt.Println("\nThe user's email is not verified yet, so the book cannot be borrowed; done.")
+		return
 	}
 
 	// 6. Borrow the book
 	fmt.Println("\n[6] Borrowing a book...")
 	borrowResp, err := client.BorrowBook(ctx, &pb.BorrowBookRequest{
-		UserId: userID,
 		BookId: bookID,
 	})
 	if err != nil {
 		log.Fatalf("BorrowBook failed: %v", err)
 	}
-	fmt.Printf("Book borrowed! Borrow ID: %s, Due date: %s\n",
-		borrowResp.BorrowId, borrowResp.DueDate)
+	fmt.Printf("Book borrowed! Borrow ID: %s, Copy: %s, Due date: %s\n",
+		borrowResp.BorrowId, borrowResp.Barcode, borrowResp.DueDate)
 	borrowID := borrowResp.BorrowId
-	fmt.Println("Book returned successfully: %v\n", borrowID)
 	// 7. Return the book
 	fmt.Println("\n[7] Returning the book...")
 	returnResp, err := client.ReturnBook(ctx, &pb.ReturnBookRequest{
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
+)
+
+const grantAdminUsage = `usage: server grant-admin <email>
+
+Grants the admin role to the registered user with the given email. Every user registers as a
+patron and only admins can grant roles, so the first admin of a deployment is made this way.`
+
+// runGrantAdmin implements the grant-admin subcommand
+func runGrantAdmin(ctx context.Context, users *repository.UserRepository, args []string) error {
+	if len(args) != 1 || args[0] == "" {
+		return fmt.Errorf("expected one email\n%s", grantAdminUsage)
+	}
+
+	user, err := users.GrantRoleByEmail(ctx, args[0], pb.Role_ROLE_ADMIN)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return fmt.Errorf("no active user is registered with %s", args[0])
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Granted the admin role to %s (%s)\n", user.Email, user.Id)
+	return nil
+}
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// AuthorizationHeader is the HTTP header and gRPC metadata key carrying the bearer token
+const AuthorizationHeader = "authorization"
+
+// Role names as stored in the database and carried in access tokens
+const (
+	RolePatron    = "patron"
+	RoleLibrarian = "librarian"
+	RoleAdmin     = "admin"
+)
+
+var ErrMissingToken = errors.New("missing bearer token")
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	UserID  string
+	Roles   []string
+	TokenID string
+}
+
+// HasRole reports whether the principal holds any of the given roles
+func (p *Principal) HasRole(roles ...string) bool {
+	for _, held := range p.Roles {
+		for _, role := range roles {
+			if held == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PrincipalFromClaims builds a Principal from verified token claims
+func PrincipalFromClaims(claims *Claims) *Principal {
+	return &Principal{
+		UserID:  claims.UserID(),
+		Roles:   claims.Roles,
+		TokenID: claims.ID,
+	}
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying the principal
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal stored in ctx, if any
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+type clientIPKey struct{}
+
+// NewClientIPContext returns a copy of ctx carrying the IP address of the client that sent the
+// request, for servers that are not reached through gRPC directly
+func NewClientIPContext(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// forwardedForKey is the gRPC metadata key in which the REST gateway passes on the address of
+// the HTTP client, appended to any X-Forwarded-For header the client sent
+const forwardedForKey = "x-forwarded-for"
+
+// ClientIPFromContext returns the IP address of the client that sent the request, taken from
+// NewClientIPContext or else the gRPC peer, or "" when it is unknown. Requests relayed by the
+// REST gateway come from a loopback peer; their client is the last X-Forwarded-For entry, the
+// one the gateway added itself.
+func ClientIPFromContext(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok && ip != "" {
+		return ip
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if forwarded := metadata.ValueFromIncomingContext(ctx, forwardedForKey); len(forwarded) > 0 {
+			entries := strings.Split(forwarded[len(forwarded)-1], ",")
+			if client := strings.TrimSpace(entries[len(entries)-1]); client != "" {
+				return client
+			}
+		}
+	}
+	return host
+}
+
+// ParseBearer extracts the token from an "Authorization: Bearer <token>" value
+func ParseBearer(header string) (string, error) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(header), " ")
+	if !found || !strings.EqualFold(scheme, "bearer") {
+		return "", ErrMissingToken
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", ErrMissingToken
+	}
+	return token, nil
+}
+
+// Authenticate verifies the bearer token in header and returns the caller
+func Authenticate(verifier TokenVerifier, header string) (*Principal, error) {
+	token, err := ParseBearer(header)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := verifier.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	return PrincipalFromClaims(claims), nil
+}
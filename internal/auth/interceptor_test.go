@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	manager, err := NewTokenManager(TokenConfig{Secret: testSecret})
+	require.NoError(t, err)
+
+	token, _, err := manager.Issue("user-id-123", []string{"patron"})
+	require.NoError(t, err)
+
+	interceptor := UnaryServerInterceptor(manager, "/pb.LibraryService/LoginUser")
+
+	// handler records the principal it was called with
+	var seen *Principal
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		seen, _ = FromContext(ctx)
+		return "ok", nil
+	}
+
+	t.Run("Valid Token", func(t *testing.T) {
+		seen = nil
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+		info := &grpc.UnaryServerInfo{FullMethod: "/pb.LibraryService/BorrowBook"}
+
+		resp, err := interceptor(ctx, nil, info, handler)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+		require.NotNil(t, seen)
+		assert.Equal(t, "user-id-123", seen.UserID)
+		assert.Equal(t, []string{"patron"}, seen.Roles)
+	})
+
+	t.Run("Missing Token", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/pb.LibraryService/BorrowBook"}
+
+		resp, err := interceptor(context.Background(), nil, info, handler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer not-a-token"))
+		info := &grpc.UnaryServerInfo{FullMethod: "/pb.LibraryService/BorrowBook"}
+
+		resp, err := interceptor(ctx, nil, info, handler)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Public Method", func(t *testing.T) {
+		seen = nil
+		info := &grpc.UnaryServerInfo{FullMethod: "/pb.LibraryService/LoginUser"}
+
+		resp, err := interceptor(context.Background(), nil, info, handler)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+		assert.Nil(t, seen)
+	})
+}
+
+func TestParseBearer(t *testing.T) {
+	token, err := ParseBearer("Bearer abc.def.ghi")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc.def.ghi", token)
+
+	token, err = ParseBearer("bearer   abc")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", token)
+
+	_, err = ParseBearer("Basic dXNlcjpwYXNz")
+	assert.ErrorIs(t, err, ErrMissingToken)
+
+	_, err = ParseBearer("")
+	assert.ErrorIs(t, err, ErrMissingToken)
+}
+
+func TestClientIPFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", ClientIPFromContext(ctx))
+
+	// gRPC requests carry the address of their peer
+	peerCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50123}})
+	assert.Equal(t, "192.0.2.1", ClientIPFromContext(peerCtx))
+
+	// An address set by another server takes precedence
+	assert.Equal(t, "2001:db8::1", ClientIPFromContext(NewClientIPContext(peerCtx, "2001:db8::1")))
+
+	// Only the REST gateway on the loopback interface is trusted to forward the client address,
+	// and only the entry it added itself
+	forwarded := metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "203.0.113.9, 198.51.100.7"))
+	gatewayCtx := peer.NewContext(forwarded, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50124}})
+	assert.Equal(t, "198.51.100.7", ClientIPFromContext(gatewayCtx))
+	remoteCtx := peer.NewContext(forwarded, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50123}})
+	assert.Equal(t, "192.0.2.1", ClientIPFromContext(remoteCtx))
+}
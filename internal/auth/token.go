@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Supported signing algorithms
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// DefaultTokenTTL is the lifetime of an access token when none is configured
+const DefaultTokenTTL = time.Hour
+
+// minSecretLength is the shortest HMAC secret accepted for HS256 (256 bits)
+const minSecretLength = 32
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+// Claims are the JWT claims carried by access tokens
+type Claims struct {
+	Roles []string `json:"roles,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the id of the user the token was issued to
+func (c *Claims) UserID() string {
+	return c.Subject
+}
+
+// TokenIssuer mints signed access tokens
+type TokenIssuer interface {
+	Issue(userID string, roles []string) (token string, expiresAt time.Time, err error)
+}
+
+// TokenVerifier validates access tokens and returns their claims
+type TokenVerifier interface {
+	Verify(token string) (*Claims, error)
+}
+
+// TokenConfig holds the settings used to build a TokenManager.
+// HS256 requires Secret, RS256 and EdDSA require KeyFile pointing at a PEM encoded private key.
+type TokenConfig struct {
+	Algorithm string
+	Secret    []byte
+	KeyFile   string
+	Issuer    string
+	TTL       time.Duration
+}
+
+// TokenManager issues and verifies JWT access tokens
+type TokenManager struct {
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+	issuer    string
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+var (
+	_ TokenIssuer   = (*TokenManager)(nil)
+	_ TokenVerifier = (*TokenManager)(nil)
+)
+
+func NewTokenManager(cfg TokenConfig) (*TokenManager, error) {
+	manager := &TokenManager{
+		issuer: cfg.Issuer,
+		ttl:    cfg.TTL,
+		now:    time.Now,
+	}
+	if manager.ttl <= 0 {
+		manager.ttl = DefaultTokenTTL
+	}
+
+	switch cfg.Algorithm {
+	case AlgorithmHS256, "":
+		if len(cfg.Secret) < minSecretLength {
+			return nil, fmt.Errorf("HS256 secret must be at least %d bytes", minSecretLength)
+		}
+		manager.method = jwt.SigningMethodHS256
+		manager.signKey = cfg.Secret
+		manager.verifyKey = cfg.Secret
+	case AlgorithmRS256:
+		pemBytes, err := readKeyFile(cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RSA private key: %w", err)
+		}
+		manager.method = jwt.SigningMethodRS256
+		manager.signKey = key
+		manager.verifyKey = key.Public().(*rsa.PublicKey)
+	case AlgorithmEdDSA:
+		pemBytes, err := readKeyFile(cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key, err := jwt.ParseEdPrivateKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Ed25519 private key: %w", err)
+		}
+		manager.method = jwt.SigningMethodEdDSA
+		manager.signKey = key
+		manager.verifyKey = key.(crypto.Signer).Public().(ed25519.PublicKey)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", cfg.Algorithm)
+	}
+
+	return manager, nil
+}
+
+// Issue creates a signed access token for the given user
+func (m *TokenManager) Issue(userID string, roles []string) (string, time.Time, error) {
+	if userID == "" {
+		return "", time.Time{}, errors.New("user id is required")
+	}
+
+	now := m.now()
+	expiresAt := now.Add(m.ttl)
+	claims := &Claims{
+		Roles: roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    m.issuer,
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(m.method, claims).SignedString(m.signKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return token, expiresAt, nil
+}
+
+// Verify checks the signature and validity window of a token and returns its claims
+func (m *TokenManager) Verify(token string) (*Claims, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{m.method.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(m.now),
+	}
+	if m.issuer != "" {
+		options = append(options, jwt.WithIssuer(m.issuer))
+	}
+
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return m.verifyKey, nil
+	}, options...)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+func readKeyFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, errors.New("key file is required")
+	}
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	return pemBytes, nil
+}
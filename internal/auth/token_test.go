@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// writePrivateKey stores a PKCS8 encoded private key in a temporary PEM file
+func writePrivateKey(t *testing.T, key interface{}) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.pem")
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)
+	require.NoError(t, err)
+	return path
+}
+
+func TestTokenManager_RoundTrip(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	configs := map[string]TokenConfig{
+		"HS256": {Algorithm: AlgorithmHS256, Secret: testSecret, Issuer: "library"},
+		"RS256": {Algorithm: AlgorithmRS256, KeyFile: writePrivateKey(t, rsaKey), Issuer: "library"},
+		"EdDSA": {Algorithm: AlgorithmEdDSA, KeyFile: writePrivateKey(t, edKey), Issuer: "library"},
+	}
+
+	for name, cfg := range configs {
+		t.Run(name, func(t *testing.T) {
+			manager, err := NewTokenManager(cfg)
+			require.NoError(t, err)
+
+			token, expiresAt, err := manager.Issue("user-id-123", []string{"patron"})
+			require.NoError(t, err)
+			assert.NotEmpty(t, token)
+			assert.WithinDuration(t, time.Now().Add(DefaultTokenTTL), expiresAt, time.Minute)
+
+			claims, err := manager.Verify(token)
+			require.NoError(t, err)
+			assert.Equal(t, "user-id-123", claims.UserID())
+			assert.Equal(t, []string{"patron"}, claims.Roles)
+			assert.Equal(t, "library", claims.Issuer)
+			assert.NotEmpty(t, claims.ID)
+			assert.NotNil(t, claims.IssuedAt)
+		})
+	}
+}
+
+func TestTokenManager_UniqueTokenIDs(t *testing.T) {
+	manager, err := NewTokenManager(TokenConfig{Secret: testSecret})
+	require.NoError(t, err)
+
+	first, _, err := manager.Issue("user-id-123", nil)
+	require.NoError(t, err)
+	second, _, err := manager.Issue("user-id-123", nil)
+	require.NoError(t, err)
+
+	firstClaims, err := manager.Verify(first)
+	require.NoError(t, err)
+	secondClaims, err := manager.Verify(second)
+	require.NoError(t, err)
+	assert.NotEqual(t, firstClaims.ID, secondClaims.ID)
+}
+
+func TestTokenManager_Verify_Rejects(t *testing.T) {
+	manager, err := NewTokenManager(TokenConfig{Secret: testSecret, Issuer: "library"})
+	require.NoError(t, err)
+
+	token, _, err := manager.Issue("user-id-123", nil)
+	require.NoError(t, err)
+
+	t.Run("Tampered", func(t *testing.T) {
+		_, err := manager.Verify(token[:len(token)-2] + "xx")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Wrong Secret", func(t *testing.T) {
+		other, err := NewTokenManager(TokenConfig{Secret: []byte("another-secret-another-secret-xx"), Issuer: "library"})
+		require.NoError(t, err)
+
+		_, err = other.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Wrong Issuer", func(t *testing.T) {
+		other, err := NewTokenManager(TokenConfig{Secret: testSecret, Issuer: "someone-else"})
+		require.NoError(t, err)
+
+		_, err = other.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		manager.now = func() time.Time { return time.Now().Add(2 * DefaultTokenTTL) }
+		defer func() { manager.now = time.Now }()
+
+		_, err := manager.Verify(token)
+		assert.ErrorIs(t, err, ErrExpiredToken)
+	})
+}
+
+func TestNewTokenManager_InvalidConfig(t *testing.T) {
+	_, err := NewTokenManager(TokenConfig{Algorithm: AlgorithmHS256, Secret: []byte("short")})
+	assert.Error(t, err)
+
+	_, err = NewTokenManager(TokenConfig{Algorithm: AlgorithmRS256})
+	assert.Error(t, err)
+
+	_, err = NewTokenManager(TokenConfig{Algorithm: "none"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,364 @@
+// Package config loads the settings of the server and client commands. Every setting has a
+// default, and can be set from a YAML file, then an environment variable, then a command-line
+// flag, each source overriding the ones before it.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"library-management-service/internal/auth"
+)
+
+// redacted replaces the values of secrets in printed configurations
+const redacted = "[REDACTED]"
+
+// Config holds the settings of the server and client commands
+type Config struct {
+	Database    Database
+	GRPC        GRPC
+	HTTP        HTTP
+	Auth        Auth
+	Pagination  Pagination
+	Mail        Mail
+	Circulation Circulation
+}
+
+// Database configures the connection to Postgres
+type Database struct {
+	URL Secret // Postgres connection string; it includes the password
+}
+
+// GRPC configures the gRPC server and its clients
+type GRPC struct {
+	ListenAddress string // Address the gRPC server listens on
+	Target        string // Address the REST gateway and the client dial
+}
+
+// HTTP configures the REST gateway
+type HTTP struct {
+	ListenAddress string // Address the REST gateway listens on
+}
+
+// Auth configures the signing of access tokens
+type Auth struct {
+	JWTAlgorithm string // HS256, RS256 or EdDSA
+	JWTSecret    Secret // Signing secret of HS256
+	JWTKeyFile   string // PEM private key of RS256 and EdDSA
+}
+
+// Pagination configures page tokens
+type Pagination struct {
+	// PageTokenSecret signs page tokens. It is required, and must be shared by every instance
+	// behind a load balancer, so that tokens survive restarts and work on every replica.
+	PageTokenSecret Secret
+}
+
+// Mail configures the delivery of account emails
+type Mail struct {
+	From    string
+	LogFile string // Messages are appended here when no SMTP host is set, or logged to stderr
+	SMTP    SMTP
+}
+
+// SMTP configures delivery through an SMTP server
+type SMTP struct {
+	Host     string // Selects delivery through an SMTP server
+	Port     string
+	Username string
+	Password Secret
+}
+
+// Circulation configures the circulation policy
+type Circulation struct {
+	PolicyFile string // Falls back to policy.DefaultRules when empty
+}
+
+// Secret is a setting whose value is never printed. Besides being set directly, a secret can be
+// read from a file named by its setting with a _file suffix, such as DATABASE_URL_FILE.
+type Secret string
+
+// String returns the redacted form of the secret
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// Default returns the configuration used when no setting is given
+func Default() *Config {
+	return &Config{
+		GRPC: GRPC{
+			ListenAddress: ":50052",
+			Target:        "localhost:50052",
+		},
+		HTTP: HTTP{
+			ListenAddress: ":8086",
+		},
+		Auth: Auth{
+			JWTAlgorithm: auth.AlgorithmHS256,
+		},
+	}
+}
+
+// setting binds a configuration value to its key in the file, environment variable and flag
+type setting struct {
+	key    string // Dotted path in the file; the flag name uses - for _
+	env    string
+	value  *string
+	secret bool
+	usage  string
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		{"database.url", "DATABASE_URL", (*string)(&c.Database.URL), true, "Postgres connection string"},
+		{"grpc.listen_address", "GRPC_LISTEN_ADDRESS", &c.GRPC.ListenAddress, false, "address the gRPC server listens on"},
+		{"grpc.target", "GRPC_TARGET", &c.GRPC.Target, false, "address of the gRPC server to dial"},
+		{"http.listen_address", "HTTP_LISTEN_ADDRESS", &c.HTTP.ListenAddress, false, "address the REST gateway listens on"},
+		{"auth.jwt_algorithm", "JWT_ALGORITHM", &c.Auth.JWTAlgorithm, false, "access token signing algorithm: HS256, RS256 or EdDSA"},
+		{"auth.jwt_secret", "JWT_SECRET", (*string)(&c.Auth.JWTSecret), true, "HS256 signing secret"},
+		{"auth.jwt_key_file", "JWT_KEY_FILE", &c.Auth.JWTKeyFile, false, "PEM private key of RS256 and EdDSA"},
+		{"pagination.page_token_secret", "PAGE_TOKEN_SECRET", (*string)(&c.Pagination.PageTokenSecret), true, "page token signing secret shared by all instances"},
+		{"mail.from", "MAIL_FROM", &c.Mail.From, false, "sender of account emails"},
+		{"mail.log_file", "MAIL_LOG_FILE", &c.Mail.LogFile, false, "file mail is appended to when no SMTP host is set"},
+		{"mail.smtp.host", "SMTP_HOST", &c.Mail.SMTP.Host, false, "SMTP server host"},
+		{"mail.smtp.port", "SMTP_PORT", &c.Mail.SMTP.Port, false, "SMTP server port"},
+		{"mail.smtp.username", "SMTP_USERNAME", &c.Mail.SMTP.Username, false, "SMTP user name"},
+		{"mail.smtp.password", "SMTP_PASSWORD", (*string)(&c.Mail.SMTP.Password), true, "SMTP password"},
+		{"circulation.policy_file", "CIRCULATION_POLICY_FILE", &c.Circulation.PolicyFile, false, "circulation rules file"},
+	}
+}
+
+// flagName returns the name of the flag of a setting. Secrets are only accepted from files on
+// the command line, where values would be visible to other users of the host.
+func (s setting) flagName() string {
+	name := strings.ReplaceAll(s.key, "_", "-")
+	if s.secret {
+		name += "-file"
+	}
+	return name
+}
+
+// Load registers the flags of every setting on fs, parses args and returns the configuration.
+// The YAML file is named by the -config flag or CONFIG_FILE. lookupEnv is usually os.LookupEnv.
+func Load(fs *flag.FlagSet, args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	cfg := Default()
+	settings := cfg.settings()
+
+	configFile := fs.String("config", "", "YAML configuration `file` (env CONFIG_FILE)")
+	flags := make(map[string]*string, len(settings))
+	for _, s := range settings {
+		usage := s.usage + " (env " + s.env + ")"
+		if s.secret {
+			usage = "`file` containing the " + s.usage + " (env " + s.env + "_FILE)"
+		}
+		flags[s.flagName()] = fs.String(s.flagName(), "", usage)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	path := *configFile
+	if env, ok := lookupEnv("CONFIG_FILE"); ok && !set["config"] {
+		path = env
+	}
+	file := map[string]string{}
+	if path != "" {
+		var err error
+		if file, err = readFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range settings {
+		// The file, then the environment, then flags
+		if err := s.set(file, s.key, s.key+"_file"); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		env := map[string]string{}
+		if value, ok := lookupEnv(s.env); ok {
+			env[s.env] = value
+		}
+		if value, ok := lookupEnv(s.env + "_FILE"); ok && s.secret {
+			env[s.env+"_FILE"] = value
+		}
+		if err := s.set(env, s.env, s.env+"_FILE"); err != nil {
+			return nil, err
+		}
+		if set[s.flagName()] {
+			value := *flags[s.flagName()]
+			if s.secret {
+				var err error
+				if value, err = readSecret("-"+s.flagName(), value); err != nil {
+					return nil, err
+				}
+			}
+			*s.value = value
+		}
+		delete(file, s.key)
+		if s.secret {
+			delete(file, s.key+"_file")
+		}
+	}
+
+	if len(file) > 0 {
+		unknown := make([]string, 0, len(file))
+		for key := range file {
+			unknown = append(unknown, key)
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("%s: unknown settings %s", path, strings.Join(unknown, ", "))
+	}
+	return cfg, nil
+}
+
+// set assigns the setting from values, where it is named name or, for secrets, fileName
+func (s setting) set(values map[string]string, name, fileName string) error {
+	value, hasValue := values[name]
+	secretFile, hasFile := values[fileName]
+	hasFile = hasFile && s.secret
+
+	switch {
+	case hasValue && hasFile:
+		return fmt.Errorf("only one of %s and %s may be set", name, fileName)
+	case hasFile:
+		secret, err := readSecret(fileName, secretFile)
+		if err != nil {
+			return err
+		}
+		*s.value = secret
+	case hasValue:
+		*s.value = value
+	}
+	return nil
+}
+
+// readSecret reads a secret from the file at path, named by the setting name. The line break
+// that editors leave at the end of files is not part of the secret.
+func readSecret(name, path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// readFile reads a YAML configuration file into its values by dotted key
+func readFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration: %w", err)
+	}
+	var document map[string]any
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	values := map[string]string{}
+	if err := flatten(values, "", document); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return values, nil
+}
+
+func flatten(values map[string]string, prefix string, node map[string]any) error {
+	for key, value := range node {
+		switch value := value.(type) {
+		case map[string]any:
+			if err := flatten(values, prefix+key+".", value); err != nil {
+				return err
+			}
+		case []any:
+			return fmt.Errorf("%s%s: lists are not supported", prefix, key)
+		case nil:
+			// An empty key, or a section with every setting commented out
+		default:
+			values[prefix+key] = fmt.Sprint(value)
+		}
+	}
+	return nil
+}
+
+// Validate reports every setting the server cannot start with
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Database.URL == "" {
+		errs = append(errs, errors.New("database.url is required"))
+	}
+	if c.Pagination.PageTokenSecret == "" {
+		errs = append(errs, errors.New("pagination.page_token_secret is required"))
+	}
+	for key, address := range map[string]string{
+		"grpc.listen_address": c.GRPC.ListenAddress,
+		"grpc.target":         c.GRPC.Target,
+		"http.listen_address": c.HTTP.ListenAddress,
+	} {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			errs = append(errs, fmt.Errorf("%s %q is not a host:port address", key, address))
+		}
+	}
+
+	switch c.Auth.JWTAlgorithm {
+	case auth.AlgorithmHS256:
+		if c.Auth.JWTSecret == "" {
+			errs = append(errs, errors.New("auth.jwt_secret is required by HS256"))
+		}
+	case auth.AlgorithmRS256, auth.AlgorithmEdDSA:
+		if c.Auth.JWTKeyFile == "" {
+			errs = append(errs, fmt.Errorf("auth.jwt_key_file is required by %s", c.Auth.JWTAlgorithm))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("auth.jwt_algorithm %q is not HS256, RS256 or EdDSA", c.Auth.JWTAlgorithm))
+	}
+
+	if c.Mail.SMTP.Port != "" {
+		if port, err := strconv.Atoi(c.Mail.SMTP.Port); err != nil || port < 1 || port > 65535 {
+			errs = append(errs, fmt.Errorf("mail.smtp.port %q is not a port number", c.Mail.SMTP.Port))
+		}
+	}
+	if c.Mail.SMTP.Password != "" && c.Mail.SMTP.Username == "" {
+		errs = append(errs, errors.New("mail.smtp.password is set without mail.smtp.username"))
+	}
+
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errors.Join(errs...)
+}
+
+// Print writes the configuration as YAML, with the values of secrets redacted
+func (c *Config) Print(w io.Writer) error {
+	document := map[string]any{}
+	for _, s := range c.settings() {
+		node := document
+		keys := strings.Split(s.key, ".")
+		for _, key := range keys[:len(keys)-1] {
+			if _, ok := node[key]; !ok {
+				node[key] = map[string]any{}
+			}
+			node = node[key].(map[string]any)
+		}
+		value := *s.value
+		if s.secret && value != "" {
+			value = redacted
+		}
+		node[keys[len(keys)-1]] = value
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
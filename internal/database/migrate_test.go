@@ -0,0 +1,301 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("Embedded", func(t *testing.T) {
+		migrations, err := LoadMigrations(embeddedMigrations, "migrations")
+
+		require.NoError(t, err)
+		require.NotEmpty(t, migrations)
+		assert.Equal(t, int64(1), migrations[0].Version)
+		assert.Equal(t, "initial_schema", migrations[0].Name)
+		assert.Contains(t, migrations[0].Up, "CREATE TABLE IF NOT EXISTS books")
+		for i := 1; i < len(migrations); i++ {
+			assert.Greater(t, migrations[i].Version, migrations[i-1].Version)
+		}
+	})
+
+	t.Run("Ordered By Version", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"m/0010_later.up.sql":   {Data: []byte("up 10")},
+			"m/0010_later.down.sql": {Data: []byte("down 10")},
+			"m/0002_first.up.sql":   {Data: []byte("up 2")},
+			"m/0002_first.down.sql": {Data: []byte("down 2")},
+		}
+
+		migrations, err := LoadMigrations(fsys, "m")
+
+		require.NoError(t, err)
+		require.Len(t, migrations, 2)
+		assert.Equal(t, int64(2), migrations[0].Version)
+		assert.Equal(t, "up 2", migrations[0].Up)
+		assert.Equal(t, "down 2", migrations[0].Down)
+		assert.Equal(t, int64(10), migrations[1].Version)
+	})
+
+	t.Run("Missing Down Script", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"m/0001_only_up.up.sql": {Data: []byte("up")},
+		}
+
+		_, err := LoadMigrations(fsys, "m")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "needs both up and down")
+	})
+
+	t.Run("Invalid File Name", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"m/create_books.sql": {Data: []byte("up")},
+		}
+
+		_, err := LoadMigrations(fsys, "m")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Conflicting Names", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"m/0001_one.up.sql":   {Data: []byte("up")},
+			"m/0001_two.down.sql": {Data: []byte("down")},
+		}
+
+		_, err := LoadMigrations(fsys, "m")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestMigrator(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, Name: "first", Up: "up 1", Down: "down 1"},
+		{Version: 2, Name: "second", Up: "up 2", Down: "down 2"},
+		{Version: 3, Name: "third", Up: "up 3", Down: "down 3"},
+	}
+	ctx := context.Background()
+
+	t.Run("Up Applies Pending Once", func(t *testing.T) {
+		store := newFakeMigrationStore()
+		store.applied[1] = true
+		migrator := &Migrator{db: &DB{Pool: store}, migrations: migrations}
+
+		applied, err := migrator.Up(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, applied)
+		assert.Equal(t, []string{"up 2", "up 3"}, store.scripts)
+		assert.Equal(t, []int64{1, 2, 3}, store.versions())
+		assert.Equal(t, store.transactions, store.locks, "every transaction must hold the advisory lock")
+
+		// Running again is a no-op
+		applied, err = migrator.Up(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, applied)
+		assert.Equal(t, []string{"up 2", "up 3"}, store.scripts)
+	})
+
+	t.Run("Down Rolls Back Newest First", func(t *testing.T) {
+		store := newFakeMigrationStore()
+		store.applied[1], store.applied[2], store.applied[3] = true, true, true
+		migrator := &Migrator{db: &DB{Pool: store}, migrations: migrations}
+
+		rolledBack, err := migrator.Down(ctx, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, rolledBack)
+		assert.Equal(t, []string{"down 3", "down 2"}, store.scripts)
+		assert.Equal(t, []int64{1}, store.versions())
+	})
+
+	t.Run("Down Stops When Empty", func(t *testing.T) {
+		store := newFakeMigrationStore()
+		store.applied[1] = true
+		migrator := &Migrator{db: &DB{Pool: store}, migrations: migrations}
+
+		rolledBack, err := migrator.Down(ctx, 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, rolledBack)
+		assert.Empty(t, store.versions())
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		store := newFakeMigrationStore()
+		store.applied[1] = true
+		migrator := &Migrator{db: &DB{Pool: store}, migrations: migrations}
+
+		statuses, err := migrator.Status(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+		assert.True(t, statuses[0].Applied)
+		assert.False(t, statuses[1].Applied)
+		assert.False(t, statuses[2].Applied)
+	})
+
+	t.Run("Force", func(t *testing.T) {
+		store := newFakeMigrationStore()
+		store.applied[1], store.applied[2], store.applied[3] = true, true, true
+		migrator := &Migrator{db: &DB{Pool: store}, migrations: migrations}
+
+		err := migrator.Force(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Empty(t, store.scripts, "force must not run migration SQL")
+		assert.Equal(t, []int64{1}, store.versions())
+
+		err = migrator.Force(ctx, 3)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, store.versions())
+
+		err = migrator.Force(ctx, 42)
+		assert.ErrorIs(t, err, ErrUnknownMigration)
+	})
+}
+
+// fakeMigrationStore is an in-memory PgxPool understanding the schema_migrations statements
+type fakeMigrationStore struct {
+	applied      map[int64]bool
+	scripts      []string
+	transactions int
+	locks        int
+}
+
+func newFakeMigrationStore() *fakeMigrationStore {
+	return &fakeMigrationStore{applied: make(map[int64]bool)}
+}
+
+func (s *fakeMigrationStore) versions() []int64 {
+	versions := make([]int64, 0, len(s.applied))
+	for version := range s.applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
+
+func (s *fakeMigrationStore) Acquire(context.Context) (*pgxpool.Conn, error) { return nil, nil }
+func (s *fakeMigrationStore) Begin(ctx context.Context) (pgx.Tx, error) {
+	return s.BeginTx(ctx, pgx.TxOptions{})
+}
+func (s *fakeMigrationStore) BeginTx(context.Context, pgx.TxOptions) (pgx.Tx, error) {
+	s.transactions++
+	return &fakeMigrationTx{store: s}, nil
+}
+func (s *fakeMigrationStore) Close() {}
+func (s *fakeMigrationStore) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	panic("migrations must run inside a transaction")
+}
+func (s *fakeMigrationStore) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	panic("migrations must run inside a transaction")
+}
+func (s *fakeMigrationStore) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	panic("migrations must run inside a transaction")
+}
+
+// fakeMigrationTx applies statements directly to the store; rollback is not simulated
+type fakeMigrationTx struct {
+	pgx.Tx
+	store *fakeMigrationStore
+}
+
+func (tx *fakeMigrationTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	switch {
+	case strings.Contains(sql, "pg_advisory_xact_lock"):
+		tx.store.locks++
+	case strings.Contains(sql, "CREATE TABLE IF NOT EXISTS schema_migrations"):
+	case strings.Contains(sql, "INSERT INTO schema_migrations"):
+		tx.store.applied[args[0].(int64)] = true
+	case strings.Contains(sql, "DELETE FROM schema_migrations WHERE version = $1"):
+		delete(tx.store.applied, args[0].(int64))
+	case strings.Contains(sql, "DELETE FROM schema_migrations WHERE version > $1"):
+		for version := range tx.store.applied {
+			if version > args[0].(int64) {
+				delete(tx.store.applied, version)
+			}
+		}
+	default:
+		tx.store.scripts = append(tx.store.scripts, sql)
+	}
+	return pgconn.CommandTag("OK"), nil
+}
+
+func (tx *fakeMigrationTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	switch {
+	case strings.Contains(sql, "SELECT EXISTS"):
+		return fakeRow{values: []interface{}{tx.store.applied[args[0].(int64)]}}
+	case strings.Contains(sql, "ORDER BY version DESC"):
+		versions := tx.store.versions()
+		if len(versions) == 0 {
+			return fakeRow{err: pgx.ErrNoRows}
+		}
+		return fakeRow{values: []interface{}{versions[len(versions)-1]}}
+	}
+	panic("unexpected query: " + sql)
+}
+
+func (tx *fakeMigrationTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	rows := &fakeRows{}
+	for _, version := range tx.store.versions() {
+		rows.values = append(rows.values, []interface{}{version, time.Now()})
+	}
+	return rows, nil
+}
+
+func (tx *fakeMigrationTx) Commit(context.Context) error   { return nil }
+func (tx *fakeMigrationTx) Rollback(context.Context) error { return pgx.ErrTxClosed }
+
+type fakeRow struct {
+	values []interface{}
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	return assign(dest, r.values)
+}
+
+type fakeRows struct {
+	pgx.Rows
+	values [][]interface{}
+	index  int
+}
+
+func (r *fakeRows) Next() bool {
+	r.index++
+	return r.index <= len(r.values)
+}
+
+func (r *fakeRows) Scan(dest ...interface{}) error { return assign(dest, r.values[r.index-1]) }
+func (r *fakeRows) Err() error                     { return nil }
+func (r *fakeRows) Close()                         {}
+
+func assign(dest []interface{}, values []interface{}) error {
+	for i, value := range values {
+		switch d := dest[i].(type) {
+		case *bool:
+			*d = value.(bool)
+		case *int64:
+			*d = value.(int64)
+		case *time.Time:
+			*d = value.(time.Time)
+		}
+	}
+	return nil
+}
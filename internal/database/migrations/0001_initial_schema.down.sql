@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS borrows;
+DROP TABLE IF EXISTS books;
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS users;
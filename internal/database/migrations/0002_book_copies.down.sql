@@ -0,0 +1,11 @@
+ALTER TABLE books ADD COLUMN available BOOLEAN DEFAULT TRUE;
+
+UPDATE books
+SET available = EXISTS (
+    SELECT 1 FROM book_copies
+    WHERE book_copies.book_id = books.id AND book_copies.status = 'available'
+);
+
+ALTER TABLE borrows DROP COLUMN copy_id;
+
+DROP TABLE book_copies;
@@ -0,0 +1,29 @@
+-- Physical copies (items) of a title. Availability now lives on the copy.
+CREATE TABLE book_copies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    barcode VARCHAR(64) NOT NULL UNIQUE,
+    branch VARCHAR(255) NOT NULL DEFAULT 'main',
+    condition VARCHAR(32) NOT NULL DEFAULT 'good'
+        CHECK (condition IN ('new', 'good', 'fair', 'poor', 'damaged')),
+    status VARCHAR(32) NOT NULL DEFAULT 'available'
+        CHECK (status IN ('available', 'on_loan', 'on_hold', 'lost', 'maintenance')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX book_copies_book_id_status_idx ON book_copies (book_id, status);
+
+-- Every existing book becomes a title with a single copy
+INSERT INTO book_copies (book_id, barcode, status)
+SELECT id, 'LEGACY-' || id::text, CASE WHEN available THEN 'available' ELSE 'on_loan' END
+FROM books;
+
+ALTER TABLE borrows ADD COLUMN copy_id UUID REFERENCES book_copies(id);
+
+UPDATE borrows
+SET copy_id = book_copies.id
+FROM book_copies
+WHERE book_copies.book_id = borrows.book_id;
+
+ALTER TABLE books DROP COLUMN available;
@@ -0,0 +1,4 @@
+-- Copies set aside for ready holds go back on the shelf
+UPDATE book_copies SET status = 'available' WHERE status = 'on_hold';
+
+DROP TABLE holds;
@@ -0,0 +1,21 @@
+-- FIFO hold queue per title. A ready hold has a copy set aside until expires_at.
+CREATE TABLE holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    copy_id UUID REFERENCES book_copies(id),
+    status VARCHAR(32) NOT NULL DEFAULT 'waiting'
+        CHECK (status IN ('waiting', 'ready', 'fulfilled', 'cancelled', 'expired')),
+    placed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ready_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- A user holds a title at most once at a time
+CREATE UNIQUE INDEX holds_active_user_book_idx ON holds (book_id, user_id)
+    WHERE status IN ('waiting', 'ready');
+
+CREATE INDEX holds_queue_idx ON holds (book_id, placed_at) WHERE status = 'waiting';
+CREATE INDEX holds_ready_expiry_idx ON holds (expires_at) WHERE status = 'ready';
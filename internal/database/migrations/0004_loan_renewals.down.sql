@@ -0,0 +1,3 @@
+DROP TABLE borrow_renewals;
+
+ALTER TABLE borrows DROP COLUMN renewal_count;
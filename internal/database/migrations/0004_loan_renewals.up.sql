@@ -0,0 +1,14 @@
+-- Number of times a loan has been extended
+ALTER TABLE borrows ADD COLUMN renewal_count INT NOT NULL DEFAULT 0;
+
+-- One row per renewal of a loan
+CREATE TABLE borrow_renewals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    borrow_id UUID NOT NULL REFERENCES borrows(id) ON DELETE CASCADE,
+    renewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    previous_due_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    new_due_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    renewed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX borrow_renewals_borrow_id_idx ON borrow_renewals (borrow_id);
@@ -0,0 +1,6 @@
+DROP INDEX borrows_open_user_id_idx;
+
+DROP TABLE circulation_policies;
+
+ALTER TABLE book_copies DROP COLUMN item_type;
+ALTER TABLE users DROP COLUMN patron_type;
@@ -0,0 +1,21 @@
+-- Circulation policies are keyed by the patron type of the borrower and the item type of the copy
+ALTER TABLE users ADD COLUMN patron_type VARCHAR(64) NOT NULL DEFAULT 'standard';
+ALTER TABLE book_copies ADD COLUMN item_type VARCHAR(64) NOT NULL DEFAULT 'standard';
+
+-- Overrides for the rules in the policy file; '*' matches any type
+CREATE TABLE circulation_policies (
+    patron_type VARCHAR(64) NOT NULL,
+    item_type VARCHAR(64) NOT NULL,
+    loan_days INT NOT NULL CHECK (loan_days >= 0),
+    max_loans INT NOT NULL CHECK (max_loans >= 0),
+    max_renewals INT NOT NULL CHECK (max_renewals >= 0),
+    renewal_grace_days INT NOT NULL DEFAULT 0 CHECK (renewal_grace_days >= 0),
+    fine_per_day_cents BIGINT NOT NULL DEFAULT 0 CHECK (fine_per_day_cents >= 0),
+    max_fine_cents BIGINT NOT NULL DEFAULT 0 CHECK (max_fine_cents >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (patron_type, item_type)
+);
+
+-- Open loans are counted against max_loans on every borrow
+CREATE INDEX borrows_open_user_id_idx ON borrows (user_id) WHERE return_date IS NULL;
@@ -0,0 +1,5 @@
+DROP TABLE ledger_entries;
+
+ALTER TABLE circulation_policies
+    DROP COLUMN max_balance_cents,
+    DROP COLUMN fine_grace_days;
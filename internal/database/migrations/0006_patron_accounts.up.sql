@@ -0,0 +1,23 @@
+-- Fines start after a grace period, and borrowing is blocked while a patron owes too much
+ALTER TABLE circulation_policies
+    ADD COLUMN fine_grace_days INT NOT NULL DEFAULT 0 CHECK (fine_grace_days >= 0),
+    ADD COLUMN max_balance_cents BIGINT NOT NULL DEFAULT 0 CHECK (max_balance_cents >= 0);
+
+-- Patron account ledger. The balance owed is the sum of charges less payments and waivers.
+CREATE TABLE ledger_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('charge', 'payment', 'waiver')),
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    borrow_id UUID REFERENCES borrows(id) ON DELETE SET NULL,
+    waived_entry_id UUID REFERENCES ledger_entries(id) ON DELETE CASCADE,
+    note TEXT NOT NULL DEFAULT '',
+    recorded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ledger_entries_user_id_idx ON ledger_entries (user_id, created_at);
+
+-- A fine is waived at most once
+CREATE UNIQUE INDEX ledger_entries_waived_entry_id_idx ON ledger_entries (waived_entry_id)
+    WHERE waived_entry_id IS NOT NULL;
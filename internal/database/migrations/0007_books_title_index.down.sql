@@ -0,0 +1 @@
+DROP INDEX books_title_id_idx;
@@ -0,0 +1,2 @@
+-- Keyset pagination walks books in (title, id) order
+CREATE INDEX books_title_id_idx ON books (title, id);
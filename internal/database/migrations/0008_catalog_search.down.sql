@@ -0,0 +1,9 @@
+DROP TRIGGER books_search_vector_update ON books;
+DROP FUNCTION books_search_vector();
+
+ALTER TABLE books
+    DROP COLUMN search_vector,
+    DROP COLUMN language,
+    DROP COLUMN publication_year,
+    DROP COLUMN subjects,
+    DROP COLUMN description;
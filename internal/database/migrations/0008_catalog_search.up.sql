@@ -0,0 +1,30 @@
+-- Descriptive fields that catalogue search matches and filters on
+ALTER TABLE books
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN subjects TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN publication_year INT CHECK (publication_year > 0),
+    ADD COLUMN language VARCHAR(35) NOT NULL DEFAULT '',
+    ADD COLUMN search_vector TSVECTOR;
+
+-- The search document weights matches in the title above the author, subjects and description.
+-- It is kept by a trigger because array_to_string is not immutable and so cannot feed a
+-- generated column.
+CREATE FUNCTION books_search_vector() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', NEW.title), 'A') ||
+        setweight(to_tsvector('english', NEW.author), 'B') ||
+        setweight(to_tsvector('english', array_to_string(NEW.subjects, ' ')), 'C') ||
+        setweight(to_tsvector('english', NEW.description), 'D');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER books_search_vector_update
+    BEFORE INSERT OR UPDATE OF title, author, subjects, description ON books
+    FOR EACH ROW EXECUTE FUNCTION books_search_vector();
+
+-- Index the books catalogued before search existed
+UPDATE books SET title = title;
+
+CREATE INDEX books_search_vector_idx ON books USING GIN (search_vector);
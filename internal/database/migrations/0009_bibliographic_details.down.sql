@@ -0,0 +1,8 @@
+DROP TABLE book_contributors;
+DROP TABLE authors;
+
+ALTER TABLE books
+    DROP COLUMN cover_url,
+    DROP COLUMN page_count,
+    DROP COLUMN edition,
+    DROP COLUMN publisher;
@@ -0,0 +1,32 @@
+ALTER TABLE books
+    ADD COLUMN publisher VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN edition VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN page_count INT CHECK (page_count > 0),
+    ADD COLUMN cover_url TEXT NOT NULL DEFAULT '';
+
+-- People credited on books. books.author keeps the display form of the authors' names.
+CREATE TABLE authors (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE book_contributors (
+    book_id UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES authors(id),
+    role VARCHAR(32) NOT NULL CHECK (role IN ('author', 'editor', 'translator')),
+    position INT NOT NULL, -- Order of the credits
+    PRIMARY KEY (book_id, author_id, role)
+);
+
+CREATE INDEX book_contributors_author_id_idx ON book_contributors (author_id);
+
+-- Credit the existing books to their authors
+INSERT INTO authors (name)
+SELECT DISTINCT author FROM books
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO book_contributors (book_id, author_id, role, position)
+SELECT books.id, authors.id, 'author', 0
+FROM books
+JOIN authors ON authors.name = books.author;
@@ -0,0 +1,12 @@
+-- Withdrawn copies had no status of their own before this version
+UPDATE book_copies SET status = 'lost' WHERE status = 'withdrawn';
+ALTER TABLE book_copies DROP CONSTRAINT book_copies_status_check;
+ALTER TABLE book_copies ADD CONSTRAINT book_copies_status_check
+    CHECK (status IN ('available', 'on_loan', 'on_hold', 'lost', 'maintenance'));
+
+DROP INDEX books_isbn_idx;
+ALTER TABLE books ADD CONSTRAINT books_isbn_key UNIQUE (isbn);
+
+ALTER TABLE books
+    DROP COLUMN deleted_at,
+    DROP COLUMN version;
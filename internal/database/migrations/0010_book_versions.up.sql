@@ -0,0 +1,14 @@
+-- version is bumped by every update so that concurrent edits can be detected.
+-- Deleted books are kept for the loan history that refers to them.
+ALTER TABLE books
+    ADD COLUMN version INT NOT NULL DEFAULT 1,
+    ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- A deleted book's ISBN may be catalogued again
+ALTER TABLE books DROP CONSTRAINT books_isbn_key;
+CREATE UNIQUE INDEX books_isbn_idx ON books (isbn) WHERE deleted_at IS NULL;
+
+-- The copies of a deleted book are withdrawn from circulation
+ALTER TABLE book_copies DROP CONSTRAINT book_copies_status_check;
+ALTER TABLE book_copies ADD CONSTRAINT book_copies_status_check
+    CHECK (status IN ('available', 'on_loan', 'on_hold', 'lost', 'maintenance', 'withdrawn'));
@@ -0,0 +1,8 @@
+DROP INDEX users_name_id_idx;
+
+ALTER TABLE users
+    DROP COLUMN deactivated_at,
+    DROP COLUMN email_verified;
+
+-- Recorded by 0013, which grandfathers the accounts of a fresh email_verified column
+DROP TABLE IF EXISTS email_verification_grandfathered;
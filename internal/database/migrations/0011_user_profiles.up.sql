@@ -0,0 +1,8 @@
+-- Users verify their email address, and may be deactivated instead of deleted so that their
+-- loans and ledger stay on record
+ALTER TABLE users
+    ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN deactivated_at TIMESTAMP WITH TIME ZONE;
+
+-- ListUsers walks users in (name, id) order
+CREATE INDEX users_name_id_idx ON users (name, id);
@@ -0,0 +1,8 @@
+-- email_verification_grandfathered is kept, so that applying the migration again does not
+-- grandfather accounts a second time; it goes with the email_verified column in 0011
+DELETE FROM user_tokens WHERE purpose = 'email_verification';
+
+ALTER TABLE user_tokens
+    DROP CONSTRAINT user_tokens_purpose_check,
+    ADD CONSTRAINT user_tokens_purpose_check CHECK (purpose IN ('password_reset')),
+    DROP COLUMN email;
@@ -0,0 +1,19 @@
+-- Email verification tokens are bound to the address they were mailed to, so that a token
+-- cannot verify an address the user changed to afterwards
+ALTER TABLE user_tokens
+    ADD COLUMN email VARCHAR(255),
+    DROP CONSTRAINT user_tokens_purpose_check,
+    ADD CONSTRAINT user_tokens_purpose_check CHECK (purpose IN ('password_reset', 'email_verification'));
+
+-- Accounts registered before verification was required keep their borrowing privileges. They
+-- are grandfathered once: the marker row outlives a rollback of this migration, so that applying
+-- it again does not verify accounts that registered or changed their email in the meantime.
+CREATE TABLE IF NOT EXISTS email_verification_grandfathered (
+    grandfathered_at TIMESTAMPTZ NOT NULL
+);
+
+UPDATE users SET email_verified = TRUE
+WHERE NOT EXISTS (SELECT 1 FROM email_verification_grandfathered);
+
+INSERT INTO email_verification_grandfathered (grandfathered_at)
+SELECT NOW() WHERE NOT EXISTS (SELECT 1 FROM email_verification_grandfathered);
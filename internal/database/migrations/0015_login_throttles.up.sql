@@ -0,0 +1,8 @@
+-- Failed login attempts per account email and per client IP address. Lockouts are stored here
+-- rather than in memory so that they survive restarts and apply across instances.
+CREATE TABLE login_throttles (
+    key TEXT PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP WITH TIME ZONE,
+    locked_until TIMESTAMP WITH TIME ZONE
+);
@@ -0,0 +1,2 @@
+DROP INDEX borrows_book_id_borrow_date_idx;
+DROP INDEX borrows_user_id_borrow_date_idx;
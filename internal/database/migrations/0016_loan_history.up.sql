@@ -0,0 +1,3 @@
+-- Loan listings read all of a user's or a title's borrows, including returned ones
+CREATE INDEX borrows_user_id_borrow_date_idx ON borrows (user_id, borrow_date);
+CREATE INDEX borrows_book_id_borrow_date_idx ON borrows (book_id, borrow_date);
@@ -0,0 +1,141 @@
+// Package lifecycle runs the long-lived components of a server and shuts them down in order
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// ErrShutdownTimeout is reported when components are still running at the shutdown deadline
+var ErrShutdownTimeout = errors.New("components still running at the shutdown deadline")
+
+type component struct {
+	name string
+	run  func() error
+	stop func(context.Context) error
+}
+
+type closer struct {
+	name  string
+	close func()
+}
+
+// Manager starts the components of a server together. When its context ends or a component
+// fails, it stops the components in the reverse order of registration, waits for them with a
+// deadline and then runs the closers.
+type Manager struct {
+	root       context.Context
+	cancelRoot context.CancelFunc
+	timeout    time.Duration
+	components []component
+	closers    []closer
+}
+
+// New returns a manager that gives the components timeout to stop gracefully
+func New(timeout time.Duration) *Manager {
+	root, cancel := context.WithCancel(context.Background())
+	return &Manager{root: root, cancelRoot: cancel, timeout: timeout}
+}
+
+// Context returns the root context of the server. It ends once every component has stopped, so
+// that the work of requests being drained is not cancelled, and before the closers run.
+func (m *Manager) Context() context.Context {
+	return m.root
+}
+
+// Add registers a component. run blocks while the component serves and returns nil once stop
+// has been called; stop should give up on a graceful stop when its context ends.
+func (m *Manager) Add(name string, run func() error, stop func(context.Context) error) {
+	m.components = append(m.components, component{name: name, run: run, stop: stop})
+}
+
+// Go registers a background task that runs until its context is cancelled at shutdown
+func (m *Manager) Go(name string, task func(context.Context)) {
+	ctx, cancel := context.WithCancel(m.root)
+	done := make(chan struct{})
+	m.Add(name, func() error {
+		defer close(done)
+		task(ctx)
+		return nil
+	}, func(stopCtx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+		case <-stopCtx.Done():
+			return stopCtx.Err()
+		}
+	})
+}
+
+// OnClose registers close to run after every component has stopped. Closers run in the reverse
+// order of registration, so that the resources acquired first, like the database pool, are
+// released last.
+func (m *Manager) OnClose(name string, close func()) {
+	m.closers = append(m.closers, closer{name: name, close: close})
+}
+
+// Run starts the components and blocks until ctx ends or a component fails, then shuts down.
+// It returns the failure that caused the shutdown, if any, with the errors of the shutdown.
+func (m *Manager) Run(ctx context.Context) error {
+	failures := make(chan error, len(m.components))
+	var running sync.WaitGroup
+	for _, c := range m.components {
+		running.Add(1)
+		go func() {
+			defer running.Done()
+			if err := c.run(); err != nil {
+				failures <- fmt.Errorf("%s: %w", c.name, err)
+			}
+		}()
+	}
+
+	var errs []error
+	select {
+	case <-ctx.Done():
+		log.Println("Shutting down")
+	case err := <-failures:
+		log.Printf("Shutting down after a failure: %v", err)
+		errs = append(errs, err)
+	}
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+	for i := len(m.components) - 1; i >= 0; i-- {
+		c := m.components[i]
+		if err := c.stop(stopCtx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", c.name, err))
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-stopCtx.Done():
+		errs = append(errs, ErrShutdownTimeout)
+	}
+	m.cancelRoot()
+
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		log.Printf("Closing %s", m.closers[i].name)
+		m.closers[i].close()
+	}
+
+	// Failures while stopping are reported too
+	for {
+		select {
+		case err := <-failures:
+			errs = append(errs, err)
+		default:
+			return errors.Join(errs...)
+		}
+	}
+}
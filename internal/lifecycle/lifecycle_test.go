@@ -0,0 +1,119 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the order in which components stop and closers run
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// addServer adds a component that serves until it is stopped
+func (r *recorder) addServer(m *Manager, name string) {
+	stopped := make(chan struct{})
+	m.Add(name, func() error {
+		<-stopped
+		return nil
+	}, func(context.Context) error {
+		r.record("stop " + name)
+		close(stopped)
+		return nil
+	})
+}
+
+func TestManager_Run_ShutdownOrder(t *testing.T) {
+	m := New(time.Second)
+	var r recorder
+	m.OnClose("database", func() { r.record("close database") })
+	r.addServer(m, "grpc")
+	r.addServer(m, "http")
+	m.OnClose("connection", func() { r.record("close connection") })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, m.Run(ctx))
+
+	assert.Equal(t, []string{"stop http", "stop grpc", "close connection", "close database"}, r.events)
+	assert.Error(t, m.Context().Err())
+}
+
+// TestManager_Run_Failure tests that a failing component shuts down the others and is returned
+func TestManager_Run_Failure(t *testing.T) {
+	m := New(time.Second)
+	var r recorder
+	r.addServer(m, "grpc")
+	failure := errors.New("address already in use")
+	m.Add("http", func() error { return failure }, func(context.Context) error { return nil })
+
+	err := m.Run(context.Background())
+
+	assert.ErrorIs(t, err, failure)
+	assert.ErrorContains(t, err, "http: address already in use")
+	assert.Equal(t, []string{"stop grpc"}, r.events)
+}
+
+// TestManager_Run_Timeout tests that components still running at the deadline are reported,
+// and that the closers run anyway
+func TestManager_Run_Timeout(t *testing.T) {
+	m := New(10 * time.Millisecond)
+	block := make(chan struct{})
+	defer close(block)
+	m.Add("stuck", func() error {
+		<-block
+		return nil
+	}, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	closed := false
+	m.OnClose("database", func() { closed = true })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := m.Run(ctx)
+
+	assert.ErrorIs(t, err, ErrShutdownTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, closed)
+}
+
+// TestManager_Go tests that background tasks are cancelled on shutdown, while the root context
+// stays alive until every component has stopped
+func TestManager_Go(t *testing.T) {
+	m := New(time.Second)
+	var rootAlive bool
+	m.Add("server", func() error {
+		return nil
+	}, func(context.Context) error {
+		rootAlive = m.Context().Err() == nil
+		return nil
+	})
+	var taskCancelled bool
+	m.Go("task", func(ctx context.Context) {
+		<-ctx.Done()
+		taskCancelled = true
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, m.Run(ctx))
+
+	assert.True(t, taskCancelled)
+	assert.True(t, rootAlive)
+	assert.Error(t, m.Context().Err())
+}
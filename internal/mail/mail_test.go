@@ -0,0 +1,119 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogMailer(t *testing.T) {
+	var buf bytes.Buffer
+	mailer := NewLogMailer(&buf, "Library <library@example.com>")
+
+	err := mailer.Send(context.Background(), Message{
+		To:      "ada@example.com",
+		Subject: "Reset your password",
+		Body:    "Your code is 1234\nIt expires in an hour",
+	})
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "From: Library <library@example.com>\r\n")
+	assert.Contains(t, out, "To: ada@example.com\r\n")
+	assert.Contains(t, out, "Subject: Reset your password\r\n")
+	assert.Contains(t, out, "\r\n\r\nYour code is 1234\r\nIt expires in an hour\r\n")
+}
+
+func TestLogMailer_HeaderInjection(t *testing.T) {
+	var buf bytes.Buffer
+	mailer := NewLogMailer(&buf, "library@example.com")
+
+	err := mailer.Send(context.Background(), Message{
+		To:      "ada@example.com\r\nBcc: eve@example.com",
+		Subject: "Reset your password",
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidHeader)
+	assert.Empty(t, buf.String())
+}
+
+func TestNewSMTPMailer_Validation(t *testing.T) {
+	cases := map[string]SMTPConfig{
+		"Missing Host":   {From: "library@example.com"},
+		"Missing Sender": {Host: "smtp.example.com"},
+		"Invalid Sender": {Host: "smtp.example.com", From: "not an address"},
+	}
+
+	for name, config := range cases {
+		_, err := NewSMTPMailer(config)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// A minimal SMTP server that records the envelope and message it receives
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		var transcript []string
+		_ = text.PrintfLine("220 localhost ready")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				break
+			}
+			transcript = append(transcript, line)
+			switch {
+			case strings.HasPrefix(line, "EHLO"):
+				_ = text.PrintfLine("250 localhost")
+			case line == "DATA":
+				_ = text.PrintfLine("354 go ahead")
+				body, _ := text.ReadDotLines()
+				transcript = append(transcript, body...)
+				_ = text.PrintfLine("250 queued")
+			case line == "QUIT":
+				_ = text.PrintfLine("221 bye")
+				received <- transcript
+				return
+			default:
+				_ = text.PrintfLine("250 ok")
+			}
+		}
+		received <- transcript
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	mailer, err := NewSMTPMailer(SMTPConfig{Host: host, Port: port, From: "Library <library@example.com>"})
+	require.NoError(t, err)
+
+	err = mailer.Send(context.Background(), Message{
+		To:      "ada@example.com",
+		Subject: "Reset your password",
+		Body:    "Your code is 1234\n.",
+	})
+	require.NoError(t, err)
+
+	transcript := <-received
+	assert.Contains(t, transcript, "MAIL FROM:<library@example.com>")
+	assert.Contains(t, transcript, "RCPT TO:<ada@example.com>")
+	assert.Contains(t, transcript, "Subject: Reset your password")
+	assert.Contains(t, transcript, "Your code is 1234")
+	// A lone dot in the body is stuffed on the wire and unstuffed by the server
+	assert.Contains(t, transcript, ".")
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/policy"
 	"library-management-service/internal/repository"
 	pb "library-management-service/proto/library/v1"
 )
@@ -41,6 +43,150 @@ func (m *MockUserRepository) GetByID(ctx context.Context, id string) (*pb.User,
 	return args.Get(0).(*pb.User), args.Error(1)
 }
 
+func (m *MockUserRepository) UpdateProfile(ctx context.Context, userID, name, email string) (*pb.User, error) {
+	args := m.Called(ctx, userID, name, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	args := m.Called(ctx, userID, currentPassword, newPassword)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) Deactivate(ctx context.Context, userID string) (*pb.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) List(ctx context.Context, query string, includeDeactivated bool, limit int32, after *pagination.Cursor) ([]*pb.User, error) {
+	args := m.Called(ctx, query, includeDeactivated, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) CreatePasswordReset(ctx context.Context, email, token string, expiresAt time.Time, interval time.Duration) (*pb.User, error) {
+	args := m.Called(ctx, email, token, expiresAt, interval)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) ResetPassword(ctx context.Context, token, newPassword string) error {
+	args := m.Called(ctx, token, newPassword)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) CreateEmailVerification(ctx context.Context, userID, token string, expiresAt time.Time, interval time.Duration) (*pb.User, error) {
+	args := m.Called(ctx, userID, token, expiresAt, interval)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) VerifyEmail(ctx context.Context, token string) (*pb.User, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) CreateSession(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	args := m.Called(ctx, userID, token, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) RotateSession(ctx context.Context, token, newToken string, expiresAt time.Time) (*pb.User, error) {
+	args := m.Called(ctx, token, newToken, expiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) RevokeSession(ctx context.Context, token string, allSessions bool) error {
+	args := m.Called(ctx, token, allSessions)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) LoginLockedUntil(ctx context.Context, keys ...string) (time.Time, error) {
+	args := m.Called(ctx, keys)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockUserRepository) RecordLoginFailure(ctx context.Context, key string, lockout repository.LoginLockout) (time.Time, error) {
+	args := m.Called(ctx, key, lockout)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (m *MockUserRepository) ClearLoginFailures(ctx context.Context, keys ...string) error {
+	args := m.Called(ctx, keys)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GrantRole(ctx context.Context, userID string, role pb.Role) (*pb.User, error) {
+	args := m.Called(ctx, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) RevokeRole(ctx context.Context, userID string, role pb.Role) (*pb.User, error) {
+	args := m.Called(ctx, userID, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) SetPatronType(ctx context.Context, userID, patronType string) (*pb.User, error) {
+	args := m.Called(ctx, userID, patronType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetBalance(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepository) ListLedgerEntries(ctx context.Context, userID string) ([]*pb.LedgerEntry, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.LedgerEntry), args.Error(1)
+}
+
+func (m *MockUserRepository) RecordPayment(ctx context.Context, userID string, amountCents int64, note, recordedBy string) (*pb.LedgerEntry, int64, error) {
+	args := m.Called(ctx, userID, amountCents, note, recordedBy)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).(*pb.LedgerEntry), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockUserRepository) WaiveFine(ctx context.Context, chargeID, note, recordedBy string) (*pb.LedgerEntry, int64, error) {
+	args := m.Called(ctx, chargeID, note, recordedBy)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).(*pb.LedgerEntry), args.Get(1).(int64), args.Error(2)
+}
+
 // Ensure type safety by verifying that MockBookRepository implements BookRepositoryInterface
 var _ repository.BookRepositoryInterface = (*MockBookRepository)(nil)
 
@@ -49,12 +195,13 @@ type MockBookRepository struct {
 	mock.Mock
 }
 
-func (m *MockBookRepository) Create(ctx context.Context, book *pb.Book) (*pb.Book, error) {
-	args := m.Called(ctx, book)
+func (m *MockBookRepository) Create(ctx context.Context, book *pb.Book, copies []*pb.BookCopy) (*pb.Book, []*pb.BookCopy, error) {
+	args := m.Called(ctx, book, copies)
 	if args.Get(0) == nil {
-		return nil, args.Error(1)
+		return nil, nil, args.Error(2)
 	}
-	return args.Get(0).(*pb.Book), args.Error(1)
+	createdCopies, _ := args.Get(1).([]*pb.BookCopy)
+	return args.Get(0).(*pb.Book), createdCopies, args.Error(2)
 }
 
 func (m *MockBookRepository) GetByID(ctx context.Context, id string) (*pb.Book, error) {
@@ -65,20 +212,134 @@ func (m *MockBookRepository) GetByID(ctx context.Context, id string) (*pb.Book,
 	return args.Get(0).(*pb.Book), args.Error(1)
 }
 
-func (m *MockBookRepository) List(ctx context.Context, limit, offset int32) ([]*pb.Book, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockBookRepository) List(ctx context.Context, limit int32, after *pagination.Cursor) ([]*pb.Book, error) {
+	args := m.Called(ctx, limit, after)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*pb.Book), args.Error(1)
 }
 
-func (m *MockBookRepository) BorrowBook(ctx context.Context, userID, bookID string, dueDate time.Time) (string, error) {
-	args := m.Called(ctx, userID, bookID, dueDate)
-	return args.String(0), args.Error(1)
+func (m *MockBookRepository) Count(ctx context.Context) (int32, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int32), args.Error(1)
 }
 
-func (m *MockBookRepository) ReturnBook(ctx context.Context, borrowID string) error {
-	args := m.Called(ctx, borrowID)
+func (m *MockBookRepository) Search(ctx context.Context, search repository.BookSearch, limit int32, after *pagination.Cursor) ([]*pb.BookSearchResult, error) {
+	args := m.Called(ctx, search, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.BookSearchResult), args.Error(1)
+}
+
+func (m *MockBookRepository) Update(ctx context.Context, book *pb.Book, paths []string) (*pb.Book, error) {
+	args := m.Called(ctx, book, paths)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Book), args.Error(1)
+}
+
+func (m *MockBookRepository) Delete(ctx context.Context, id string, version int32) error {
+	args := m.Called(ctx, id, version)
 	return args.Error(0)
 }
+
+func (m *MockBookRepository) BorrowBook(ctx context.Context, userID, bookID, copyID string, rules policy.Resolver) (*repository.Borrow, error) {
+	args := m.Called(ctx, userID, bookID, copyID, rules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Borrow), args.Error(1)
+}
+
+func (m *MockBookRepository) ReturnBook(ctx context.Context, borrowID string, holdExpiresAt time.Time, rules policy.Resolver) (*repository.Return, error) {
+	args := m.Called(ctx, borrowID, holdExpiresAt, rules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Return), args.Error(1)
+}
+
+func (m *MockBookRepository) GetBorrowerID(ctx context.Context, borrowID string) (string, error) {
+	args := m.Called(ctx, borrowID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockBookRepository) AddCopy(ctx context.Context, copy *pb.BookCopy, holdExpiresAt time.Time) (*pb.BookCopy, error) {
+	args := m.Called(ctx, copy, holdExpiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.BookCopy), args.Error(1)
+}
+
+func (m *MockBookRepository) ListCopies(ctx context.Context, bookID string) ([]*pb.BookCopy, error) {
+	args := m.Called(ctx, bookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.BookCopy), args.Error(1)
+}
+
+func (m *MockBookRepository) UpdateCopy(ctx context.Context, copy *pb.BookCopy, holdExpiresAt time.Time) (*pb.BookCopy, error) {
+	args := m.Called(ctx, copy, holdExpiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.BookCopy), args.Error(1)
+}
+
+func (m *MockBookRepository) PlaceHold(ctx context.Context, userID, bookID string) (*pb.Hold, error) {
+	args := m.Called(ctx, userID, bookID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Hold), args.Error(1)
+}
+
+func (m *MockBookRepository) GetHold(ctx context.Context, id string) (*pb.Hold, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Hold), args.Error(1)
+}
+
+func (m *MockBookRepository) CancelHold(ctx context.Context, id string, holdExpiresAt time.Time) (*pb.Hold, error) {
+	args := m.Called(ctx, id, holdExpiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Hold), args.Error(1)
+}
+
+func (m *MockBookRepository) ListHolds(ctx context.Context, bookID, userID string, includeClosed bool) ([]*pb.Hold, error) {
+	args := m.Called(ctx, bookID, userID, includeClosed)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.Hold), args.Error(1)
+}
+
+func (m *MockBookRepository) ExpireHolds(ctx context.Context, holdExpiresAt time.Time) (int, error) {
+	args := m.Called(ctx, holdExpiresAt)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookRepository) RenewLoan(ctx context.Context, borrowID, renewedBy string, rules policy.Resolver) (*repository.Renewal, error) {
+	args := m.Called(ctx, borrowID, renewedBy, rules)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Renewal), args.Error(1)
+}
+
+func (m *MockBookRepository) ListLoans(ctx context.Context, filter repository.LoanFilter, limit int32, after *pagination.Cursor) ([]*pb.Loan, error) {
+	args := m.Called(ctx, filter, limit, after)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.Loan), args.Error(1)
+}
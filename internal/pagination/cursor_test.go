@@ -0,0 +1,44 @@
+package pagination
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodec_RoundTrip(t *testing.T) {
+	codec := NewCodec([]byte("test-page-token-key"))
+	cursor := Cursor{Key: "The Hobbit", ID: "book-id-123"}
+
+	token := codec.Encode(cursor)
+	decoded, err := codec.Decode(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+	assert.NotContains(t, token, "Hobbit")
+}
+
+func TestCodec_RejectsTampering(t *testing.T) {
+	codec := NewCodec([]byte("test-page-token-key"))
+	token := codec.Encode(Cursor{Key: "The Hobbit", ID: "book-id-123"})
+	payload, signature, _ := strings.Cut(token, ".")
+
+	// A token for another position, signed with a different key
+	forged := NewCodec([]byte("another-key")).Encode(Cursor{Key: "A", ID: "book-id-1"})
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	cases := map[string]string{
+		"Empty":             "",
+		"No Signature":      payload,
+		"Wrong Key":         forged,
+		"Swapped Payload":   forgedPayload + "." + signature,
+		"Garbled Signature": payload + ".!!!",
+	}
+
+	for name, token := range cases {
+		_, err := codec.Decode(token)
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+}
@@ -0,0 +1,38 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// fileConfig is the layout of a policy file:
+//
+//	{"rules": [{"patron_type": "*", "item_type": "*", "loan_days": 14, ...}]}
+type fileConfig struct {
+	Rules []Rule `json:"rules"`
+}
+
+// LoadFile reads and validates the rules in a JSON policy file
+func LoadFile(path string) ([]Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	var config fileConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", path, err)
+	}
+	if len(config.Rules) == 0 {
+		return nil, fmt.Errorf("policy file %s defines no rules", path)
+	}
+
+	for _, rule := range config.Rules {
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rule in %s: %w", path, err)
+		}
+	}
+
+	return config.Rules, nil
+}
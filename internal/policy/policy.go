@@ -0,0 +1,174 @@
+// Package policy decides the circulation terms — loan period, loan and renewal limits and
+// overdue fines — that apply to a patron type borrowing an item type.
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Any matches every patron type or item type in a rule
+const Any = "*"
+
+// DefaultType is the patron type of new users and the item type of new copies
+const DefaultType = "standard"
+
+// Rule is the circulation policy for one combination of patron type and item type
+type Rule struct {
+	PatronType       string `json:"patron_type"`
+	ItemType         string `json:"item_type"`
+	LoanDays         int    `json:"loan_days"`
+	MaxLoans         int    `json:"max_loans"` // Concurrent loans; 0 makes the item type reference only
+	MaxRenewals      int    `json:"max_renewals"`
+	RenewalGraceDays int    `json:"renewal_grace_days"` // How long after its due date a loan may still be renewed
+	FinePerDayCents  int64  `json:"fine_per_day_cents"`
+	MaxFineCents     int64  `json:"max_fine_cents"`    // 0 leaves fines uncapped
+	FineGraceDays    int    `json:"fine_grace_days"`   // Days overdue before a fine is charged
+	MaxBalanceCents  int64  `json:"max_balance_cents"` // Borrowing is refused while more is owed; 0 never refuses
+}
+
+// DefaultRules apply when no policy file is configured
+var DefaultRules = []Rule{{
+	PatronType:       Any,
+	ItemType:         Any,
+	LoanDays:         14,
+	MaxLoans:         10,
+	MaxRenewals:      2,
+	RenewalGraceDays: 3,
+	FinePerDayCents:  25,
+	MaxFineCents:     1000,
+	FineGraceDays:    1,
+	MaxBalanceCents:  500,
+}}
+
+// LoanPeriod is how long a loan or a renewal lasts
+func (r Rule) LoanPeriod() time.Duration {
+	return time.Duration(r.LoanDays) * 24 * time.Hour
+}
+
+// RenewalGracePeriod is how long after its due date a loan may still be renewed
+func (r Rule) RenewalGracePeriod() time.Duration {
+	return time.Duration(r.RenewalGraceDays) * 24 * time.Hour
+}
+
+// DaysOverdue counts the started days between the due date and returnedAt
+func DaysOverdue(dueDate, returnedAt time.Time) int {
+	late := returnedAt.Sub(dueDate)
+	if late <= 0 {
+		return 0
+	}
+	return int((late + 24*time.Hour - 1) / (24 * time.Hour))
+}
+
+// Fine is the overdue fine for a loan returned daysOverdue days late. Loans returned within
+// the fine grace period are not charged; past it, every day overdue is.
+func (r Rule) Fine(daysOverdue int) int64 {
+	if daysOverdue <= r.FineGraceDays {
+		return 0
+	}
+	fine := int64(daysOverdue) * r.FinePerDayCents
+	if r.MaxFineCents > 0 && fine > r.MaxFineCents {
+		fine = r.MaxFineCents
+	}
+	return fine
+}
+
+// Validate checks that a rule is complete and its limits are not negative
+func (r Rule) Validate() error {
+	if r.PatronType == "" || r.ItemType == "" {
+		return fmt.Errorf("patron type and item type are required (use %q to match any)", Any)
+	}
+	if r.LoanDays < 0 || r.MaxLoans < 0 || r.MaxRenewals < 0 || r.RenewalGraceDays < 0 ||
+		r.FinePerDayCents < 0 || r.MaxFineCents < 0 || r.FineGraceDays < 0 || r.MaxBalanceCents < 0 {
+		return fmt.Errorf("rule for %s/%s has negative limits", r.PatronType, r.ItemType)
+	}
+	if r.MaxLoans > 0 && r.LoanDays == 0 {
+		return fmt.Errorf("rule for %s/%s allows loans but has no loan period", r.PatronType, r.ItemType)
+	}
+	return nil
+}
+
+// matches reports whether the rule applies, and how specifically: an exact patron type
+// outranks an exact item type, which outranks a wildcard
+func (r Rule) matches(patronType, itemType string) (int, bool) {
+	score := 0
+	switch r.PatronType {
+	case patronType:
+		score += 2
+	case Any:
+	default:
+		return 0, false
+	}
+	switch r.ItemType {
+	case itemType:
+		score++
+	case Any:
+	default:
+		return 0, false
+	}
+	return score, true
+}
+
+// Resolver looks up the rule that applies to a patron type borrowing an item type
+type Resolver interface {
+	Resolve(ctx context.Context, patronType, itemType string) (Rule, error)
+	// Using returns a Resolver that reads stored rules from store instead, so that a lookup
+	// made inside a transaction can read them through it
+	Using(store Store) Resolver
+}
+
+// Store provides rules kept in the database
+type Store interface {
+	ListPolicies(ctx context.Context) ([]Rule, error)
+}
+
+// Engine resolves rules from a base set, typically loaded from a file, with rules from a
+// Store overriding base rules for the same patron type and item type
+type Engine struct {
+	rules []Rule
+	store Store
+}
+
+// NewEngine returns an Engine over rules; store may be nil
+func NewEngine(rules []Rule, store Store) *Engine {
+	return &Engine{rules: rules, store: store}
+}
+
+// Using returns an Engine over the same base rules that reads overrides from store. An Engine
+// created without a store has no overrides to read and is returned unchanged.
+func (e *Engine) Using(store Store) Resolver {
+	if e.store == nil {
+		return e
+	}
+	return &Engine{rules: e.rules, store: store}
+}
+
+// Resolve returns the most specific rule for the patron type and item type, falling back to
+// the first of DefaultRules when nothing matches
+func (e *Engine) Resolve(ctx context.Context, patronType, itemType string) (Rule, error) {
+	if patronType == "" {
+		patronType = DefaultType
+	}
+	if itemType == "" {
+		itemType = DefaultType
+	}
+
+	rules := e.rules
+	if e.store != nil {
+		overrides, err := e.store.ListPolicies(ctx)
+		if err != nil {
+			return Rule{}, fmt.Errorf("failed to load circulation policies: %w", err)
+		}
+		// Overrides come last so that they win ties with the base rules
+		rules = append(append([]Rule(nil), e.rules...), overrides...)
+	}
+
+	best, bestScore := DefaultRules[0], -1
+	for _, rule := range rules {
+		if score, ok := rule.matches(patronType, itemType); ok && score >= bestScore {
+			best, bestScore = rule, score
+		}
+	}
+	return best, nil
+}
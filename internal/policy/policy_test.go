@@ -0,0 +1,140 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticStore serves a fixed set of database overrides
+type staticStore struct {
+	rules []Rule
+	err   error
+}
+
+func (s staticStore) ListPolicies(ctx context.Context) ([]Rule, error) {
+	return s.rules, s.err
+}
+
+func TestEngine_Resolve(t *testing.T) {
+	rules := []Rule{
+		{PatronType: Any, ItemType: Any, LoanDays: 14, MaxLoans: 5},
+		{PatronType: Any, ItemType: "dvd", LoanDays: 7, MaxLoans: 2},
+		{PatronType: "faculty", ItemType: Any, LoanDays: 90, MaxLoans: 50},
+		{PatronType: "faculty", ItemType: "dvd", LoanDays: 14, MaxLoans: 5},
+	}
+	engine := NewEngine(rules, nil)
+	ctx := context.Background()
+
+	cases := []struct {
+		patronType, itemType string
+		wantDays             int
+	}{
+		{"standard", "standard", 14},
+		{"standard", "dvd", 7},
+		{"faculty", "standard", 90},
+		{"faculty", "dvd", 14},
+		{"", "", 14},
+	}
+
+	for _, tc := range cases {
+		rule, err := engine.Resolve(ctx, tc.patronType, tc.itemType)
+		require.NoError(t, err)
+		assert.Equal(t, tc.wantDays, rule.LoanDays, "%s/%s", tc.patronType, tc.itemType)
+	}
+}
+
+func TestEngine_Resolve_StoreOverrides(t *testing.T) {
+	base := []Rule{{PatronType: Any, ItemType: Any, LoanDays: 14, MaxLoans: 5}}
+	store := staticStore{rules: []Rule{{PatronType: Any, ItemType: Any, LoanDays: 21, MaxLoans: 5}}}
+	engine := NewEngine(base, store)
+
+	rule, err := engine.Resolve(context.Background(), "standard", "standard")
+	require.NoError(t, err)
+	assert.Equal(t, 21, rule.LoanDays)
+
+	engine = NewEngine(base, staticStore{err: errors.New("connection refused")})
+	_, err = engine.Resolve(context.Background(), "standard", "standard")
+	assert.ErrorContains(t, err, "failed to load circulation policies")
+}
+
+func TestEngine_Using(t *testing.T) {
+	base := []Rule{{PatronType: Any, ItemType: Any, LoanDays: 14, MaxLoans: 5}}
+	store := staticStore{err: errors.New("connection pool exhausted")}
+	txStore := staticStore{rules: []Rule{{PatronType: Any, ItemType: Any, LoanDays: 21, MaxLoans: 5}}}
+
+	// The overrides are read from the store given to Using, not the engine's own
+	rule, err := NewEngine(base, store).Using(txStore).Resolve(context.Background(), "standard", "standard")
+	require.NoError(t, err)
+	assert.Equal(t, 21, rule.LoanDays)
+
+	// An engine without a store keeps resolving from its base rules
+	rule, err = NewEngine(base, nil).Using(txStore).Resolve(context.Background(), "standard", "standard")
+	require.NoError(t, err)
+	assert.Equal(t, 14, rule.LoanDays)
+}
+
+func TestEngine_Resolve_FallsBackToDefaults(t *testing.T) {
+	engine := NewEngine([]Rule{{PatronType: "faculty", ItemType: Any, LoanDays: 90, MaxLoans: 50}}, nil)
+
+	rule, err := engine.Resolve(context.Background(), "standard", "standard")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRules[0], rule)
+}
+
+func TestRule_Fine(t *testing.T) {
+	rule := Rule{FinePerDayCents: 25, MaxFineCents: 100}
+	assert.Equal(t, int64(0), rule.Fine(0))
+	assert.Equal(t, int64(75), rule.Fine(3))
+	assert.Equal(t, int64(100), rule.Fine(10))
+
+	rule.MaxFineCents = 0
+	assert.Equal(t, int64(250), rule.Fine(10))
+
+	// Within the grace period nothing is charged; past it every day overdue is
+	rule.FineGraceDays = 2
+	assert.Equal(t, int64(0), rule.Fine(2))
+	assert.Equal(t, int64(75), rule.Fine(3))
+}
+
+func TestDaysOverdue(t *testing.T) {
+	due := time.Date(2030, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 0, DaysOverdue(due, due.Add(-time.Hour)))
+	assert.Equal(t, 0, DaysOverdue(due, due))
+	assert.Equal(t, 1, DaysOverdue(due, due.Add(time.Minute)))
+	assert.Equal(t, 1, DaysOverdue(due, due.Add(24*time.Hour)))
+	assert.Equal(t, 2, DaysOverdue(due, due.Add(25*time.Hour)))
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	require.NoError(t, os.WriteFile(valid, []byte(`{"rules": [
+		{"patron_type": "*", "item_type": "*", "loan_days": 21, "max_loans": 8, "max_renewals": 1},
+		{"patron_type": "*", "item_type": "reference", "max_loans": 0}
+	]}`), 0o600))
+
+	rules, err := LoadFile(valid)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, 21, rules[0].LoanDays)
+	assert.Equal(t, "reference", rules[1].ItemType)
+
+	invalid := filepath.Join(dir, "invalid.json")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{"rules": [{"patron_type": "*", "loan_days": 14}]}`), 0o600))
+	_, err = LoadFile(invalid)
+	assert.ErrorContains(t, err, "patron type and item type are required")
+
+	empty := filepath.Join(dir, "empty.json")
+	require.NoError(t, os.WriteFile(empty, []byte(`{"rules": []}`), 0o600))
+	_, err = LoadFile(empty)
+	assert.ErrorContains(t, err, "defines no rules")
+}
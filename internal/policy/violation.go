@@ -0,0 +1,38 @@
+package policy
+
+import "errors"
+
+// Machine-readable reasons a circulation request breaks the policy
+const (
+	ReasonNotLoanable        = "ITEM_NOT_LOANABLE"
+	ReasonMaxLoans           = "MAX_LOANS_REACHED"
+	ReasonMaxRenewals        = "MAX_RENEWALS_REACHED"
+	ReasonOverdueBeyondGrace = "OVERDUE_BEYOND_GRACE_PERIOD"
+	ReasonHoldsWaiting       = "HOLDS_WAITING"
+	ReasonBalanceOwed        = "BALANCE_OWED"
+)
+
+// Domain identifies policy violations in gRPC error details
+const Domain = "library.circulation"
+
+// Violation is returned when a loan or renewal is refused by the circulation policy
+type Violation struct {
+	Reason  string
+	Message string
+}
+
+func (v *Violation) Error() string {
+	return v.Message
+}
+
+// Violate returns a Violation for reason
+func Violate(reason, message string) error {
+	return &Violation{Reason: reason, Message: message}
+}
+
+// AsViolation returns the policy violation in err's chain, if any
+func AsViolation(err error) (*Violation, bool) {
+	var violation *Violation
+	ok := errors.As(err, &violation)
+	return violation, ok
+}
@@ -4,13 +4,83 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
 	"library-management-service/internal/database"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/policy"
 	pb "library-management-service/proto/library/v1"
 )
 
+// DefaultBranch is the branch copies are shelved at when none is given
+const DefaultBranch = "main"
+
+var (
+	ErrBorrowNotFound = newError(ErrNotFound, "BORROW_NOT_FOUND", "borrow not found")
+	ErrCopyNotFound   = newError(ErrNotFound, "COPY_NOT_FOUND", "copy not found")
+	// ErrBookUnavailable and ErrCopyUnavailable are returned by BorrowBook when no copy can be lent
+	ErrBookUnavailable  = newError(ErrUnavailable, "BOOK_UNAVAILABLE", "book is not available")
+	ErrCopyUnavailable  = newError(ErrUnavailable, "COPY_UNAVAILABLE", "copy is not available")
+	ErrCopyNotOfBook    = newError(ErrInvalid, "COPY_NOT_OF_BOOK", "copy does not belong to book")
+	ErrCopyInUse        = newError(ErrConflict, "COPY_IN_USE", "copy is on loan, on hold or withdrawn")
+	ErrDuplicateISBN    = newError(ErrDuplicate, "DUPLICATE_ISBN", "a book with this ISBN is already catalogued")
+	ErrDuplicateBarcode = newError(ErrDuplicate, "DUPLICATE_BARCODE", "a copy with this barcode already exists")
+	// ErrEmailNotVerified is returned by BorrowBook for borrowers who have not verified their email
+	ErrEmailNotVerified = newError(ErrConflict, "EMAIL_NOT_VERIFIED", "email address is not verified")
+)
+
+// Borrow is a loan of one physical copy to a user
+type Borrow struct {
+	ID         string
+	UserID     string
+	BookID     string
+	CopyID     string
+	Barcode    string
+	BorrowDate time.Time
+	DueDate    time.Time
+}
+
+// Return is the outcome of closing a borrow
+type Return struct {
+	BorrowID    string
+	HoldID      string // Set when the copy was set aside for a waiting hold
+	DaysOverdue int
+	FineCents   int64 // Charged to the borrower's account
+}
+
+// bookColumns are the columns scanned by scanBook. They need the copy counts and
+// contributors joined by bookJoins.
+const bookColumns = `
+	books.id, books.title, books.author, COALESCE(books.isbn, ''),
+	counts.available > 0, counts.total, counts.available,
+	books.description, books.subjects, COALESCE(books.publication_year, 0), books.language,
+	books.publisher, books.edition, COALESCE(books.page_count, 0), books.cover_url,
+	contributors.ids, contributors.names, contributors.roles, books.version`
+
+const bookJoins = `
+	CROSS JOIN LATERAL (
+		SELECT COUNT(*)::int AS total,
+			COUNT(*) FILTER (WHERE book_copies.status = 'available')::int AS available
+		FROM book_copies
+		WHERE book_copies.book_id = books.id
+	) counts
+	CROSS JOIN LATERAL (
+		SELECT COALESCE(array_agg(authors.id::text ORDER BY book_contributors.position), '{}') AS ids,
+			COALESCE(array_agg(authors.name ORDER BY book_contributors.position), '{}') AS names,
+			COALESCE(array_agg(book_contributors.role ORDER BY book_contributors.position), '{}') AS roles
+		FROM book_contributors
+		JOIN authors ON authors.id = book_contributors.author_id
+		WHERE book_contributors.book_id = books.id
+	) contributors`
+
+// selectBooks selects books together with their copy counts and contributors
+const selectBooks = "SELECT " + bookColumns + " FROM books" + bookJoins
+
+const copyColumns = "id, book_id, barcode, branch, condition, status, item_type"
+
 type BookRepository struct {
 	db *database.DB
 }
@@ -19,47 +89,94 @@ func NewBookRepository(db *database.DB) *BookRepository {
 	return &BookRepository{db: db}
 }
 
-func (r *BookRepository) Create(ctx context.Context, book *pb.Book) (*pb.Book, error) {
-	err := r.db.Pool.QueryRow(ctx, `
-		INSERT INTO books (title, author, isbn, available)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id, title, author, isbn, available
-	`, book.Title, book.Author, book.Isbn, book.Available).Scan(
-		&book.Id, &book.Title, &book.Author, &book.Isbn, &book.Available)
+// Create inserts a title together with its contributors and initial copies. A single default copy is
+// created when copies is empty, so that a new title can be borrowed straight away. Without
+// contributors, the book is credited to its author; without an author, the author is derived
+// from the contributors.
+func (r *BookRepository) Create(ctx context.Context, book *pb.Book, copies []*pb.BookCopy) (*pb.Book, []*pb.BookCopy, error) {
+	if len(copies) == 0 {
+		copies = []*pb.BookCopy{{}}
+	}
+	if len(book.Contributors) == 0 {
+		book.Contributors = []*pb.Contributor{{Name: book.Author, Role: pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR}}
+	} else if book.Author == "" {
+		book.Author = AuthorNames(book.Contributors)
+	}
+
+	created := make([]*pb.BookCopy, 0, len(copies))
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `
+			INSERT INTO books (title, author, isbn, description, subjects, publication_year, language,
+				publisher, edition, page_count, cover_url)
+			VALUES ($1, $2, NULLIF($3, ''), $4, $5, NULLIF($6, 0), $7, $8, $9, NULLIF($10, 0), $11)
+			RETURNING id, title, author, COALESCE(isbn, '')
+		`, book.Title, book.Author, book.Isbn, book.Description, nonNil(book.Subjects), book.PublicationYear, book.Language,
+			book.Publisher, book.Edition, book.PageCount, book.CoverUrl).Scan(
+			&book.Id, &book.Title, &book.Author, &book.Isbn)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return ErrDuplicateISBN
+			}
+			return fmt.Errorf("failed to create book: %w", err)
+		}
 
+		if err := insertContributors(ctx, tx, book.Id, book.Contributors); err != nil {
+			return err
+		}
+
+		for _, bookCopy := range copies {
+			bookCopy.BookId = book.Id
+			inserted, err := insertCopy(ctx, tx, bookCopy)
+			if err != nil {
+				return err
+			}
+			created = append(created, inserted)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create book: %w", err)
+		return nil, nil, err
 	}
 
-	return book, nil
+	book.TotalCopies = int32(len(created))
+	book.AvailableCopies = 0
+	for _, bookCopy := range created {
+		if bookCopy.Status == pb.CopyStatus_COPY_STATUS_AVAILABLE {
+			book.AvailableCopies++
+		}
+	}
+	book.Available = book.AvailableCopies > 0
+
+	return book, created, nil
 }
 
 func (r *BookRepository) GetByID(ctx context.Context, id string) (*pb.Book, error) {
-	var book pb.Book
-
-	err := r.db.Pool.QueryRow(ctx, `
-		SELECT id, title, author, isbn, available 
-		FROM books 
-		WHERE id = $1
-	`, id).Scan(&book.Id, &book.Title, &book.Author, &book.Isbn, &book.Available)
+	book, err := scanBook(r.db.Pool.QueryRow(ctx, selectBooks+`
+		WHERE books.id = $1 AND books.deleted_at IS NULL
+	`, id))
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("book not found")
+			return nil, ErrBookNotFound
 		}
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
-	return &book, nil
+	return book, nil
 }
 
-func (r *BookRepository) List(ctx context.Context, limit int32, offset int32) ([]*pb.Book, error) {
-	rows, err := r.db.Pool.Query(ctx, `
-		SELECT id, title, author, isbn, available 
-		FROM books 
-		ORDER BY title 
-		LIMIT $1 OFFSET $2
-	`, limit, offset)
+// List returns up to limit books ordered by title and id, starting after the cursor when one is given
+func (r *BookRepository) List(ctx context.Context, limit int32, after *pagination.Cursor) ([]*pb.Book, error) {
+	query, args := selectBooks+" WHERE books.deleted_at IS NULL", []interface{}{limit}
+	if after != nil {
+		query += " AND (books.title, books.id) > ($2, $3)"
+		args = append(args, after.Key, after.ID)
+	}
+
+	rows, err := r.db.Pool.Query(ctx, query+`
+		ORDER BY books.title, books.id
+		LIMIT $1
+	`, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list books: %w", err)
 	}
@@ -67,81 +184,437 @@ func (r *BookRepository) List(ctx context.Context, limit int32, offset int32) ([
 
 	var books []*pb.Book
 	for rows.Next() {
-		var book pb.Book
-		if err := rows.Scan(&book.Id, &book.Title, &book.Author, &book.Isbn, &book.Available); err != nil {
+		book, err := scanBook(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan book: %w", err)
 		}
-		books = append(books, &book)
+		books = append(books, book)
 	}
 
-	return books, nil
+	return books, rows.Err()
 }
 
-func (r *BookRepository) BorrowBook(ctx context.Context, userID, bookID string, dueDate time.Time) (string, error) {
-	// Since the Pool interface doesn't expose Begin directly, we need to implement
-	// transaction logic without using that method directly
+// Count returns the number of books in the catalogue
+func (r *BookRepository) Count(ctx context.Context) (int32, error) {
+	var count int32
+	if err := r.db.Pool.QueryRow(ctx, "SELECT COUNT(*)::int FROM books WHERE deleted_at IS NULL").Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count books: %w", err)
+	}
+	return count, nil
+}
+
+// BorrowBook lends copyID, or any available copy of bookID when copyID is empty, to the user.
+// A copy set aside for one of the user's ready holds is lent in preference and fulfils the hold.
+// The loan period and the user's loan limit come from the rule for the user's patron type and
+// the copy's item type.
+func (r *BookRepository) BorrowBook(ctx context.Context, userID, bookID, copyID string, rules policy.Resolver) (*Borrow, error) {
+	borrow := &Borrow{UserID: userID}
+
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Lock the borrower so that concurrent borrows cannot exceed the loan limit.
+		// Deactivated users cannot borrow, and new users only once they verify their email.
+		var patronType string
+		var openLoans int
+		var balance int64
+		var emailVerified bool
+		err := tx.QueryRow(ctx, `
+			SELECT patron_type,
+				(SELECT COUNT(*) FROM borrows WHERE user_id = users.id AND return_date IS NULL)::int,
+				`+balanceOwed+`,
+				email_verified
+			FROM users
+			WHERE id = $1 AND deactivated_at IS NULL
+			FOR UPDATE
+		`, userID).Scan(&patronType, &openLoans, &balance, &emailVerified)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("failed to get borrower: %w", err)
+		}
+		if !emailVerified {
+			return ErrEmailNotVerified
+		}
+
+		var heldCopyID string
+		err = tx.QueryRow(ctx, `
+			UPDATE holds SET status = 'fulfilled', updated_at = NOW()
+			WHERE id = (
+				SELECT id FROM holds
+				WHERE user_id = $1 AND status = 'ready' AND expires_at > NOW()
+					AND CASE WHEN $3 = '' THEN book_id::text = $2 ELSE copy_id::text = $3 END
+				ORDER BY ready_at
+				LIMIT 1
+				FOR UPDATE
+			)
+			RETURNING copy_id
+		`, userID, bookID, copyID).Scan(&heldCopyID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to fulfil hold: %w", err)
+		}
+
+		// Claim a copy with a conditional update so that only one of several
+		// concurrent borrowers can move it from available to on loan
+		var row pgx.Row
+		if heldCopyID != "" {
+			row = tx.QueryRow(ctx, `
+				UPDATE book_copies SET status = 'on_loan', updated_at = NOW()
+				WHERE id = $1 AND status = 'on_hold'
+				RETURNING id, book_id, barcode, item_type
+			`, heldCopyID)
+		} else if copyID != "" {
+			row = tx.QueryRow(ctx, `
+				UPDATE book_copies SET status = 'on_loan', updated_at = NOW()
+				WHERE id = $1 AND status = 'available'
+				RETURNING id, book_id, barcode, item_type
+			`, copyID)
+		} else {
+			row = tx.QueryRow(ctx, `
+				UPDATE book_copies SET status = 'on_loan', updated_at = NOW()
+				WHERE status = 'available' AND id = (
+					SELECT id FROM book_copies
+					WHERE book_id = $1 AND status = 'available'
+					ORDER BY barcode
+					LIMIT 1
+					FOR UPDATE SKIP LOCKED
+				)
+				RETURNING id, book_id, barcode, item_type
+			`, bookID)
+		}
+
+		var itemType string
+		err = row.Scan(&borrow.CopyID, &borrow.BookID, &borrow.Barcode, &itemType)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return unavailableError(ctx, tx, bookID, copyID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update copy availability: %w", err)
+		}
+		if bookID != "" && borrow.BookID != bookID {
+			return ErrCopyNotOfBook
+		}
+
+		rule, err := rules.Using(txPolicies{tx}).Resolve(ctx, patronType, itemType)
+		if err != nil {
+			return err
+		}
+		if rule.MaxLoans == 0 {
+			return policy.Violate(policy.ReasonNotLoanable, fmt.Sprintf("%s items cannot be borrowed", itemType))
+		}
+		if openLoans >= rule.MaxLoans {
+			return policy.Violate(policy.ReasonMaxLoans, fmt.Sprintf("loan limit of %d reached", rule.MaxLoans))
+		}
+		if rule.MaxBalanceCents > 0 && balance > rule.MaxBalanceCents {
+			return policy.Violate(policy.ReasonBalanceOwed, fmt.Sprintf("outstanding fines of %d cents must be paid first", balance))
+		}
+		borrow.DueDate = time.Now().Add(rule.LoanPeriod())
+
+		// Create borrow record
+		err = tx.QueryRow(ctx, `
+			INSERT INTO borrows (user_id, book_id, copy_id, due_date)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id, borrow_date
+		`, userID, borrow.BookID, borrow.CopyID, borrow.DueDate).Scan(&borrow.ID, &borrow.BorrowDate)
+		if err != nil {
+			return fmt.Errorf("failed to create borrow record: %w", err)
+		}
 
-	// Check if book is available
-	var available bool
-	err := r.db.Pool.QueryRow(ctx, "SELECT available FROM books WHERE id = $1", bookID).Scan(&available)
+		return nil
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to check book availability: %w", err)
+		return nil, err
+	}
+
+	return borrow, nil
+}
+
+// unavailableError explains why no copy could be claimed
+func unavailableError(ctx context.Context, tx pgx.Tx, bookID, copyID string) error {
+	query, id := "SELECT EXISTS (SELECT 1 FROM books WHERE id = $1 AND deleted_at IS NULL)", bookID
+	notFound, unavailable := ErrBookNotFound, ErrBookUnavailable
+	if copyID != "" {
+		query, id = "SELECT EXISTS (SELECT 1 FROM book_copies WHERE id = $1)", copyID
+		notFound, unavailable = ErrCopyNotFound, ErrCopyUnavailable
+	}
+
+	var exists bool
+	if err := tx.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check availability: %w", err)
 	}
-	if !available {
-		return "", fmt.Errorf("book is not available")
+	if !exists {
+		return notFound
 	}
+	return unavailable
+}
+
+// ReturnBook closes a borrow and charges any overdue fine, under the rule for the borrower's
+// patron type and the copy's item type, to the borrower's account. The returned copy is set aside for the next waiting
+// hold on the title until holdExpiresAt.
+func (r *BookRepository) ReturnBook(ctx context.Context, borrowID string, holdExpiresAt time.Time, rules policy.Resolver) (*Return, error) {
+	result := &Return{BorrowID: borrowID}
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Close the borrow record; the return_date guard makes a second return a no-op
+		var copyID, patronType, itemType string
+		var dueDate, returnedAt time.Time
+		err := tx.QueryRow(ctx, `
+			UPDATE borrows SET return_date = NOW(), updated_at = NOW()
+			WHERE id = $1 AND return_date IS NULL
+			RETURNING copy_id, due_date, return_date,
+				(SELECT patron_type FROM users WHERE users.id = borrows.user_id),
+				(SELECT item_type FROM book_copies WHERE book_copies.id = borrows.copy_id)
+		`, borrowID).Scan(&copyID, &dueDate, &returnedAt, &patronType, &itemType)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("failed to update borrow record: %w", err)
+			}
+			var exists bool
+			err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM borrows WHERE id = $1)", borrowID).Scan(&exists)
+			if err != nil {
+				return fmt.Errorf("failed to get borrow: %w", err)
+			}
+			if !exists {
+				return ErrBorrowNotFound
+			}
+			return ErrLoanReturned
+		}
+
+		rule, err := rules.Using(txPolicies{tx}).Resolve(ctx, patronType, itemType)
+		if err != nil {
+			return err
+		}
+		result.DaysOverdue = policy.DaysOverdue(dueDate, returnedAt)
+		result.FineCents = rule.Fine(result.DaysOverdue)
+		if result.FineCents > 0 {
+			if err := chargeFine(ctx, tx, borrowID, result.FineCents, result.DaysOverdue); err != nil {
+				return err
+			}
+		}
 
-	// Update book availability
-	_, err = r.db.Pool.Exec(ctx, "UPDATE books SET available = false WHERE id = $1", bookID)
+		result.HoldID, err = releaseCopy(ctx, tx, copyID, holdExpiresAt)
+		return err
+	})
 	if err != nil {
-		return "", fmt.Errorf("failed to update book availability: %w", err)
+		return nil, err
 	}
 
-	// Create borrow record
-	var borrowID string
-	err = r.db.Pool.QueryRow(ctx, `
-		INSERT INTO borrows (user_id, book_id, due_date)
-		VALUES ($1, $2, $3)
-		RETURNING id
-	`, userID, bookID, dueDate).Scan(&borrowID)
+	return result, nil
+}
+
+func (r *BookRepository) GetBorrowerID(ctx context.Context, borrowID string) (string, error) {
+	var userID string
+	err := r.db.Pool.QueryRow(ctx, "SELECT user_id FROM borrows WHERE id = $1", borrowID).Scan(&userID)
 	if err != nil {
-		// If there was an error, try to revert the book availability
-		_, revertErr := r.db.Pool.Exec(ctx, "UPDATE books SET available = true WHERE id = $1", bookID)
-		if revertErr != nil {
-			// Log but continue with original error
-			fmt.Printf("Failed to revert book availability: %v\n", revertErr)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrBorrowNotFound
 		}
-		return "", fmt.Errorf("failed to create borrow record: %w", err)
+		return "", fmt.Errorf("database error: %w", err)
 	}
 
-	return borrowID, nil
+	return userID, nil
 }
 
-func (r *BookRepository) ReturnBook(ctx context.Context, borrowID string) error {
-	// Get book ID from borrow
-	var bookID string
-	err := r.db.Pool.QueryRow(ctx, "SELECT book_id FROM borrows WHERE id = $1", borrowID).Scan(&bookID)
+// AddCopy adds a copy of a book. A copy added as available goes to the oldest waiting hold on the
+// title first, which then has until holdExpiresAt to pick it up.
+func (r *BookRepository) AddCopy(ctx context.Context, bookCopy *pb.BookCopy, holdExpiresAt time.Time) (*pb.BookCopy, error) {
+	var added *pb.BookCopy
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		added, err = insertCopy(ctx, tx, bookCopy)
+		if err != nil {
+			return err
+		}
+		return offerCopy(ctx, tx, added, holdExpiresAt)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to get borrow: %w", err)
+		return nil, err
 	}
 
-	// Update book availability
-	_, err = r.db.Pool.Exec(ctx, "UPDATE books SET available = true WHERE id = $1", bookID)
+	return added, nil
+}
+
+func (r *BookRepository) ListCopies(ctx context.Context, bookID string) ([]*pb.BookCopy, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+copyColumns+`
+		FROM book_copies
+		WHERE book_id = $1
+		ORDER BY barcode
+	`, bookID)
 	if err != nil {
-		return fmt.Errorf("failed to update book availability: %w", err)
+		return nil, fmt.Errorf("failed to list copies: %w", err)
 	}
+	defer rows.Close()
 
-	// Update borrow record
-	_, err = r.db.Pool.Exec(ctx, "UPDATE borrows SET return_date = NOW() WHERE id = $1", borrowID)
+	var copies []*pb.BookCopy
+	for rows.Next() {
+		bookCopy, err := scanCopy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan copy: %w", err)
+		}
+		copies = append(copies, bookCopy)
+	}
+
+	return copies, rows.Err()
+}
+
+// UpdateCopy changes the branch, condition, status and item type of a copy, leaving empty or unspecified
+// fields untouched. Copies on loan or set aside for a hold only change status through circulation, and
+// withdrawn copies not at all. A copy made available goes to the oldest waiting hold on the title first,
+// which then has until holdExpiresAt to pick it up.
+func (r *BookRepository) UpdateCopy(ctx context.Context, bookCopy *pb.BookCopy, holdExpiresAt time.Time) (*pb.BookCopy, error) {
+	var condition, status string
+	if bookCopy.Condition != pb.CopyCondition_COPY_CONDITION_UNSPECIFIED {
+		condition = CopyConditionName(bookCopy.Condition)
+	}
+	if bookCopy.Status != pb.CopyStatus_COPY_STATUS_UNSPECIFIED {
+		status = CopyStatusName(bookCopy.Status)
+	}
+
+	var updated *pb.BookCopy
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		updated, err = scanCopy(tx.QueryRow(ctx, `
+			UPDATE book_copies SET
+				branch = COALESCE(NULLIF($2, ''), branch),
+				condition = COALESCE(NULLIF($3, ''), condition),
+				status = COALESCE(NULLIF($4, ''), status),
+				item_type = COALESCE(NULLIF($5, ''), item_type),
+				updated_at = NOW()
+			WHERE id = $1 AND ($4 = '' OR status NOT IN ('on_loan', 'on_hold', 'withdrawn'))
+			RETURNING `+copyColumns, bookCopy.Id, bookCopy.Branch, condition, status, bookCopy.ItemType))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				var exists bool
+				if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM book_copies WHERE id = $1)", bookCopy.Id).Scan(&exists); err != nil {
+					return fmt.Errorf("database error: %w", err)
+				}
+				if !exists {
+					return ErrCopyNotFound
+				}
+				return ErrCopyInUse
+			}
+			return fmt.Errorf("failed to update copy: %w", err)
+		}
+
+		// Only a status change puts the copy back in circulation
+		if status == "" {
+			return nil
+		}
+		return offerCopy(ctx, tx, updated, holdExpiresAt)
+	})
 	if err != nil {
-		// If this fails, try to revert the book availability
-		_, revertErr := r.db.Pool.Exec(ctx, "UPDATE books SET available = false WHERE id = $1", bookID)
-		if revertErr != nil {
-			// Log but continue with original error
-			fmt.Printf("Failed to revert book availability: %v\n", revertErr)
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func insertCopy(ctx context.Context, q database.Querier, bookCopy *pb.BookCopy) (*pb.BookCopy, error) {
+	barcode := bookCopy.Barcode
+	if barcode == "" {
+		barcode = generateBarcode()
+	}
+	branch := bookCopy.Branch
+	if branch == "" {
+		branch = DefaultBranch
+	}
+	condition := bookCopy.Condition
+	if condition == pb.CopyCondition_COPY_CONDITION_UNSPECIFIED {
+		condition = pb.CopyCondition_COPY_CONDITION_GOOD
+	}
+	status := bookCopy.Status
+	if status == pb.CopyStatus_COPY_STATUS_UNSPECIFIED {
+		status = pb.CopyStatus_COPY_STATUS_AVAILABLE
+	}
+	itemType := bookCopy.ItemType
+	if itemType == "" {
+		itemType = policy.DefaultType
+	}
+
+	created, err := scanCopy(q.QueryRow(ctx, `
+		INSERT INTO book_copies (book_id, barcode, branch, condition, status, item_type)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+copyColumns,
+		bookCopy.BookId, barcode, branch, CopyConditionName(condition), CopyStatusName(status), itemType))
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return nil, ErrDuplicateBarcode
+		case isForeignKeyViolation(err):
+			return nil, ErrBookNotFound
 		}
-		return fmt.Errorf("failed to update borrow record: %w", err)
+		return nil, fmt.Errorf("failed to create copy: %w", err)
+	}
+
+	return created, nil
+}
+
+// scanBook scans the bookColumns of a row, followed by any extra columns into extra
+func scanBook(row pgx.Row, extra ...interface{}) (*pb.Book, error) {
+	var book pb.Book
+	var contributorIDs, contributorNames, contributorRoles []string
+	dests := append([]interface{}{&book.Id, &book.Title, &book.Author, &book.Isbn,
+		&book.Available, &book.TotalCopies, &book.AvailableCopies,
+		&book.Description, &book.Subjects, &book.PublicationYear, &book.Language,
+		&book.Publisher, &book.Edition, &book.PageCount, &book.CoverUrl,
+		&contributorIDs, &contributorNames, &contributorRoles, &book.Version}, extra...)
+	if err := row.Scan(dests...); err != nil {
+		return nil, err
+	}
+	for i := range contributorIDs {
+		book.Contributors = append(book.Contributors, &pb.Contributor{
+			AuthorId: contributorIDs[i],
+			Name:     contributorNames[i],
+			Role:     ContributorRoleFromName(contributorRoles[i]),
+		})
 	}
+	return &book, nil
+}
 
+// insertContributors credits the contributors on a book in order, adding any authors not
+// catalogued yet
+func insertContributors(ctx context.Context, tx pgx.Tx, bookID string, contributors []*pb.Contributor) error {
+	for position, contributor := range contributors {
+		err := tx.QueryRow(ctx, `
+			WITH author AS (
+				INSERT INTO authors (name) VALUES ($2)
+				ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+				RETURNING id
+			)
+			INSERT INTO book_contributors (book_id, author_id, role, position)
+			SELECT $1, id, $3, $4 FROM author
+			RETURNING author_id
+		`, bookID, contributor.Name, ContributorRoleName(contributor.Role), position).Scan(&contributor.AuthorId)
+		if err != nil {
+			return fmt.Errorf("failed to add contributor: %w", err)
+		}
+	}
 	return nil
 }
+
+// AuthorNames returns the display form of the authors among contributors
+func AuthorNames(contributors []*pb.Contributor) string {
+	var names []string
+	for _, contributor := range contributors {
+		if contributor.Role == pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR {
+			names = append(names, contributor.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func scanCopy(row pgx.Row) (*pb.BookCopy, error) {
+	var bookCopy pb.BookCopy
+	var condition, status string
+	err := row.Scan(&bookCopy.Id, &bookCopy.BookId, &bookCopy.Barcode, &bookCopy.Branch, &condition, &status, &bookCopy.ItemType)
+	if err != nil {
+		return nil, err
+	}
+	bookCopy.Condition = CopyConditionFromName(condition)
+	bookCopy.Status = CopyStatusFromName(status)
+	return &bookCopy, nil
+}
+
+// generateBarcode returns a random barcode for copies created without one
+func generateBarcode() string {
+	return "LIB-" + strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", "")[:12])
+}
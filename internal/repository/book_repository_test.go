@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"library-management-service/internal/database"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/policy"
 	pb "library-management-service/proto/library/v1"
 
+	"strings"
 	"testing"
 	"time"
 )
@@ -33,6 +37,56 @@ type MockRows struct {
 	mock.Mock
 	index int
 	data  [][5]string // [id, title, author, isbn, available]
+	err   error       // reported by Err once the rows are exhausted
+}
+
+func (m *MockRows) Close() {
+	m.Called()
+}
+
+func (m *MockRows) Err() error {
+	return m.err
+}
+
+func (m *MockRows) CommandTag() pgconn.CommandTag {
+	return nil
+}
+
+func (m *MockRows) FieldDescriptions() []pgproto3.FieldDescription {
+	return nil
+}
+
+func (m *MockRows) Next() bool {
+	if m.index >= len(m.data) {
+		return false
+	}
+	m.index++
+	return true
+}
+
+func (m *MockRows) Scan(dest ...interface{}) error {
+	row := m.data[m.index-1]
+	*(dest[0].(*string)) = row[0]
+	*(dest[1].(*string)) = row[1]
+	*(dest[2].(*string)) = row[2]
+	*(dest[3].(*string)) = row[3]
+	*(dest[4].(*bool)) = row[4] == "true"
+	// Every mocked title has a single copy
+	*(dest[5].(*int32)) = 1
+	if row[4] == "true" {
+		*(dest[6].(*int32)) = 1
+	} else {
+		*(dest[6].(*int32)) = 0
+	}
+	return nil
+}
+
+func (m *MockRows) Values() ([]interface{}, error) {
+	return nil, nil
+}
+
+func (m *MockRows) RawValues() [][]byte {
+	return nil
 }
 
 func (m *MockPgxPool) Acquire(ctx context.Context) (*pgxpool.Conn, error) {
@@ -40,6 +94,16 @@ func (m *MockPgxPool) Acquire(ctx context.Context) (*pgxpool.Conn, error) {
 	return args.Get(0).(*pgxpool.Conn), args.Error(1)
 }
 
+func (m *MockPgxPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockPgxPool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	args := m.Called(ctx, txOptions)
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
 func (m *MockPgxPool) Close() {
 	m.Called()
 }
@@ -63,7 +127,10 @@ func (m *MockPgxPool) QueryRow(ctx context.Context, sql string, args ...interfac
 func TestBookRepository_Create(t *testing.T) {
 	// Setup
 	mockPool := new(MockPgxPool)
-	mockRow := new(MockRow)
+	mockTx := new(MockTx)
+	mockBookRow := new(MockRow)
+	mockContributorRow := new(MockRow)
+	mockCopyRow := new(MockRow)
 
 	db := &database.DB{
 		Pool: mockPool,
@@ -74,26 +141,50 @@ func TestBookRepository_Create(t *testing.T) {
 
 	// Test data
 	book := &pb.Book{
-		Title:     "Test Book",
-		Author:    "Test Author",
-		Isbn:      "1234567890",
-		Available: true,
+		Title:  "Test Book",
+		Author: "Test Author",
+		Isbn:   "1234567890",
 	}
+	copies := []*pb.BookCopy{{Barcode: "BC-1", Branch: "east"}}
 
 	// Expectations
-	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
-	mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. Insert the title
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBookRow).Once()
+	mockBookRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
 		// Simulate filling the book fields
 		dests := args.Get(0).([]interface{})
 		*(dests[0].(*string)) = "book-id-123"
 		*(dests[1].(*string)) = book.Title
 		*(dests[2].(*string)) = book.Author
 		*(dests[3].(*string)) = book.Isbn
-		*(dests[4].(*bool)) = book.Available
 	}).Return(nil)
 
+	// 2. Credit its author
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockContributorRow).Once()
+	mockContributorRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = "author-id-1"
+	}).Return(nil)
+
+	// 3. Insert its copy
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockCopyRow).Once()
+	mockCopyRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-1"
+		*(dests[1].(*string)) = "book-id-123"
+		*(dests[2].(*string)) = "BC-1"
+		*(dests[3].(*string)) = "east"
+		*(dests[4].(*string)) = "good"
+		*(dests[5].(*string)) = "available"
+	}).Return(nil)
+
+	// 4. Commit, followed by the deferred no-op rollback
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
 	// Execute
-	result, err := repo.Create(ctx, book)
+	result, created, err := repo.Create(ctx, book, copies)
 
 	// Verify
 	assert.NoError(t, err)
@@ -102,23 +193,84 @@ func TestBookRepository_Create(t *testing.T) {
 	assert.Equal(t, book.Title, result.Title)
 	assert.Equal(t, book.Author, result.Author)
 	assert.Equal(t, book.Isbn, result.Isbn)
-	assert.Equal(t, book.Available, result.Available)
+	assert.True(t, result.Available)
+	assert.Equal(t, int32(1), result.TotalCopies)
+	assert.Equal(t, int32(1), result.AvailableCopies)
+	assert.Len(t, created, 1)
+	assert.Equal(t, "copy-id-1", created[0].Id)
+	assert.Equal(t, pb.CopyCondition_COPY_CONDITION_GOOD, created[0].Condition)
+	assert.Equal(t, pb.CopyStatus_COPY_STATUS_AVAILABLE, created[0].Status)
+	assert.Len(t, result.Contributors, 1)
+	assert.Equal(t, "author-id-1", result.Contributors[0].AuthorId)
 
 	// Verify correct parameters were passed
-	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
-	assert.Equal(t, book.Title, argsSlice[0])
-	assert.Equal(t, book.Author, argsSlice[1])
-	assert.Equal(t, book.Isbn, argsSlice[2])
-	assert.Equal(t, book.Available, argsSlice[3])
+	bookArgsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, book.Title, bookArgsSlice[0])
+	assert.Equal(t, book.Author, bookArgsSlice[1])
+	assert.Equal(t, book.Isbn, bookArgsSlice[2])
+	contributorArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"book-id-123", "Test Author", "author", 0}, contributorArgsSlice)
+	copyArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, "book-id-123", copyArgsSlice[0])
+	assert.Equal(t, "BC-1", copyArgsSlice[1])
+	assert.Equal(t, "east", copyArgsSlice[2])
+	assert.Equal(t, "good", copyArgsSlice[3])
+	assert.Equal(t, "available", copyArgsSlice[4])
 
 	mockPool.AssertExpectations(t)
-	mockRow.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockBookRow.AssertExpectations(t)
+	mockContributorRow.AssertExpectations(t)
+	mockCopyRow.AssertExpectations(t)
+}
+
+// TestBookRepository_Create_Contributors tests that the author is derived from the credited authors
+func TestBookRepository_Create_Contributors(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Test data
+	book := &pb.Book{
+		Title: "Good Omens",
+		Contributors: []*pb.Contributor{
+			{Name: "Terry Pratchett", Role: pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+			{Name: "Someone Else", Role: pb.ContributorRole_CONTRIBUTOR_ROLE_EDITOR},
+			{Name: "Neil Gaiman", Role: pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+		},
+	}
+
+	// Expectations: the book, its three contributors and its default copy each take one query
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow).Times(5)
+	mockRow.On("Scan", mock.Anything).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	result, _, err := repo.Create(ctx, book, nil)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "Terry Pratchett, Neil Gaiman", result.Author)
+
+	bookArgsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, "Terry Pratchett, Neil Gaiman", bookArgsSlice[1])
+	editorArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"", "Someone Else", "editor", 1}, editorArgsSlice)
+
+	mockTx.AssertExpectations(t)
 }
 
 // TestBookRepository_Create_Error tests the Create method with a database error
 func TestBookRepository_Create_Error(t *testing.T) {
 	// Setup
 	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
 	mockRow := new(MockRow)
 
 	db := &database.DB{
@@ -130,25 +282,29 @@ func TestBookRepository_Create_Error(t *testing.T) {
 
 	// Test data
 	book := &pb.Book{
-		Title:     "Test Book",
-		Author:    "Test Author",
-		Isbn:      "1234567890",
-		Available: true,
+		Title:  "Test Book",
+		Author: "Test Author",
+		Isbn:   "1234567890",
 	}
 
 	// Expectations
-	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow).Once()
 	mockRow.On("Scan", mock.Anything).Return(errors.New("database error"))
+	mockTx.On("Rollback", ctx).Return(nil).Once()
 
 	// Execute
-	result, err := repo.Create(ctx, book)
+	result, created, err := repo.Create(ctx, book, nil)
 
 	// Verify
 	assert.Error(t, err)
 	assert.Nil(t, result)
+	assert.Nil(t, created)
 	assert.Contains(t, err.Error(), "failed to create book")
 
 	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
 	mockRow.AssertExpectations(t)
 }
 
@@ -185,6 +341,8 @@ func TestBookRepository_GetByID(t *testing.T) {
 		*(dests[2].(*string)) = expectedBook.Author
 		*(dests[3].(*string)) = expectedBook.Isbn
 		*(dests[4].(*bool)) = expectedBook.Available
+		*(dests[5].(*int32)) = 3
+		*(dests[6].(*int32)) = 2
 	}).Return(nil)
 
 	// Execute
@@ -198,6 +356,8 @@ func TestBookRepository_GetByID(t *testing.T) {
 	assert.Equal(t, expectedBook.Author, book.Author)
 	assert.Equal(t, expectedBook.Isbn, book.Isbn)
 	assert.Equal(t, expectedBook.Available, book.Available)
+	assert.Equal(t, int32(3), book.TotalCopies)
+	assert.Equal(t, int32(2), book.AvailableCopies)
 
 	// Verify correct ID was passed
 	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
@@ -263,14 +423,13 @@ func TestBookRepository_List(t *testing.T) {
 
 	// Test data
 	limit := int32(10)
-	offset := int32(0)
 
 	// Expectations
 	mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
 	mockRows.On("Close").Return()
 
 	// Execute
-	books, err := repo.List(ctx, limit, offset)
+	books, err := repo.List(ctx, limit, nil)
 
 	// Verify
 	assert.NoError(t, err)
@@ -293,13 +452,41 @@ func TestBookRepository_List(t *testing.T) {
 
 	// Verify correct parameters were passed
 	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
-	assert.Equal(t, limit, argsSlice[0])
-	assert.Equal(t, offset, argsSlice[1])
+	assert.Equal(t, []interface{}{limit}, argsSlice)
 
 	mockPool.AssertExpectations(t)
 	mockRows.AssertExpectations(t)
 }
 
+// TestBookRepository_List_AfterCursor tests that a page starts after the cursor in (title, id) order
+func TestBookRepository_List_AfterCursor(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRows := &MockRows{}
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+	mockRows.On("Close").Return()
+
+	// Execute
+	books, err := repo.List(ctx, 11, &pagination.Cursor{Key: "Dune", ID: "book-id-7"})
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Empty(t, books)
+
+	query := mockPool.Calls[0].Arguments[1].(string)
+	assert.Contains(t, query, "(books.title, books.id) > ($2, $3)")
+	assert.Contains(t, query, "ORDER BY books.title, books.id")
+	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{int32(11), "Dune", "book-id-7"}, argsSlice)
+
+	mockPool.AssertExpectations(t)
+}
+
 // TestBookRepository_List_QueryError tests List with a database query error
 func TestBookRepository_List_QueryError(t *testing.T) {
 	// Setup
@@ -312,15 +499,11 @@ func TestBookRepository_List_QueryError(t *testing.T) {
 	repo := NewBookRepository(db)
 	ctx := context.Background()
 
-	// Test data
-	limit := int32(10)
-	offset := int32(0)
-
 	// Expectations
 	mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return((*MockRows)(nil), errors.New("query error"))
 
 	// Execute
-	books, err := repo.List(ctx, limit, offset)
+	books, err := repo.List(ctx, 10, nil)
 
 	// Verify
 	assert.Error(t, err)
@@ -334,9 +517,9 @@ func TestBookRepository_List_QueryError(t *testing.T) {
 func TestBookRepository_BorrowBook(t *testing.T) {
 	// Setup
 	mockPool := new(MockPgxPool)
-	mockAvailableRow := new(MockRow)
+	mockTx := new(MockTx)
+	mockClaimRow := new(MockRow)
 	mockBorrowRow := new(MockRow)
-	mockCommandTag := new(MockPgxPool)
 
 	db := &database.DB{
 		Pool: mockPool,
@@ -348,36 +531,708 @@ func TestBookRepository_BorrowBook(t *testing.T) {
 	// Test data
 	userID := "user-id-123"
 	bookID := "book-id-123"
-	dueDate := time.Now().AddDate(0, 0, 14)
 	borrowID := "borrow-id-123"
 
 	// Expectations
-	// 1. Check if book is available
-	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockAvailableRow).Once()
-	mockAvailableRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
-		// Book is available
-		*(args.Get(0).([]interface{})[0].(*bool)) = true
-	}).Return(nil)
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrower(ctx, mockTx, policy.DefaultType, 3)
+	expectNoReadyHold(ctx, mockTx)
 
-	// 2. Update book availability
-	mockPool.On("Exec", ctx, mock.Anything, mock.Anything).Return(mockCommandTag, nil).Once()
-	mockCommandTag.On("RowsAffected").Return(int64(1))
+	// 1. Claim any available copy
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockClaimRow).Once()
+	mockClaimRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-1"
+		*(dests[1].(*string)) = bookID
+		*(dests[2].(*string)) = "BC-1"
+		*(dests[3].(*string)) = policy.DefaultType
+	}).Return(nil)
 
-	// 3. Create borrow record
-	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowRow).Once()
+	// 2. Create borrow record
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowRow).Once()
 	mockBorrowRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
 		*(args.Get(0).([]interface{})[0].(*string)) = borrowID
 	}).Return(nil)
 
+	// 3. Commit, followed by the deferred no-op rollback
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	result, err := repo.BorrowBook(ctx, userID, bookID, "", testRules)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, borrowID, result.ID)
+	assert.Equal(t, "copy-id-1", result.CopyID)
+	assert.Equal(t, "BC-1", result.Barcode)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 14), result.DueDate, time.Minute)
+
+	// Verify the borrower was locked
+	borrowerArgsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, userID, borrowerArgsSlice[0])
+
+	// Verify correct parameters were passed for the conditional update
+	updateArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, bookID, updateArgsSlice[0])
+
+	// Verify correct parameters were passed for the borrow record
+	insertArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, userID, insertArgsSlice[0])
+	assert.Equal(t, bookID, insertArgsSlice[1])
+	assert.Equal(t, "copy-id-1", insertArgsSlice[2])
+	assert.Equal(t, result.DueDate, insertArgsSlice[3])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockClaimRow.AssertExpectations(t)
+	mockBorrowRow.AssertExpectations(t)
+}
+
+// TestBookRepository_BorrowBook_NotAvailable tests BorrowBook when every copy is already borrowed
+func TestBookRepository_BorrowBook_NotAvailable(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockClaimRow := new(MockRow)
+	mockExistsRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrower(ctx, mockTx, policy.DefaultType, 0)
+	expectNoReadyHold(ctx, mockTx)
+
+	// 1. The conditional update claims nothing
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockClaimRow).Once()
+	mockClaimRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+
+	// 2. The book exists, so it is simply unavailable
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockExistsRow).Once()
+	mockExistsRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = true
+	}).Return(nil)
+
+	// 3. The transaction is rolled back and never committed
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	result, err := repo.BorrowBook(ctx, "user-id-123", "book-id-123", "", testRules)
+
+	// Verify
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "book is not available")
+	assert.ErrorIs(t, err, ErrBookUnavailable)
+	assert.ErrorIs(t, err, ErrUnavailable)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestBookRepository_BorrowBook_EmailNotVerified tests that borrowers must verify their email first
+func TestBookRepository_BorrowBook_EmailNotVerified(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockBorrowerRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations: the borrower is found but not verified
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowerRow).Once()
+	mockBorrowerRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = policy.DefaultType
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	result, err := repo.BorrowBook(ctx, "user-id-123", "book-id-123", "", testRules)
+
+	// Verify
+	assert.ErrorIs(t, err, ErrEmailNotVerified)
+	assert.Nil(t, result)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestBookRepository_BorrowBook_CopyNotFound tests BorrowBook with an unknown copy id
+func TestBookRepository_BorrowBook_CopyNotFound(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockClaimRow := new(MockRow)
+	mockExistsRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrower(ctx, mockTx, policy.DefaultType, 0)
+	expectNoReadyHold(ctx, mockTx)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockClaimRow).Once()
+	mockClaimRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockExistsRow).Once()
+	mockExistsRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = false
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	result, err := repo.BorrowBook(ctx, "user-id-123", "", "copy-id-404", testRules)
+
+	// Verify
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrCopyNotFound)
+
+	// The claim and the existence check both target the copy
+	claimArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, "copy-id-404", claimArgsSlice[0])
+	existsArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, "copy-id-404", existsArgsSlice[0])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_BorrowBook_WrongBook tests that a copy of another title is not lent
+func TestBookRepository_BorrowBook_WrongBook(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockClaimRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrower(ctx, mockTx, policy.DefaultType, 0)
+	expectNoReadyHold(ctx, mockTx)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockClaimRow).Once()
+	mockClaimRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-1"
+		*(dests[1].(*string)) = "other-book-id"
+		*(dests[2].(*string)) = "BC-1"
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	result, err := repo.BorrowBook(ctx, "user-id-123", "book-id-123", "copy-id-1", testRules)
+
+	// Verify
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "copy does not belong to book")
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestBookRepository_BorrowBook_InsertError tests that a failed borrow record rolls back the claim
+func TestBookRepository_BorrowBook_InsertError(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockClaimRow := new(MockRow)
+	mockBorrowRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrower(ctx, mockTx, policy.DefaultType, 0)
+	expectNoReadyHold(ctx, mockTx)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockClaimRow).Once()
+	mockClaimRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-1"
+		*(dests[1].(*string)) = "book-id-123"
+		*(dests[2].(*string)) = "BC-1"
+	}).Return(nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowRow).Once()
+	mockBorrowRow.On("Scan", mock.Anything).Return(errors.New("insert failed"))
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
 	// Execute
-	result, err := repo.BorrowBook(ctx, userID, bookID, dueDate)
+	result, err := repo.BorrowBook(ctx, "user-id-123", "book-id-123", "", testRules)
+
+	// Verify
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "failed to create borrow record")
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestBookRepository_BorrowBook_PolicyViolations tests the circulation limits checked before a loan is made
+func TestBookRepository_BorrowBook_PolicyViolations(t *testing.T) {
+	rules := policy.NewEngine([]policy.Rule{
+		{PatronType: policy.Any, ItemType: policy.Any, LoanDays: 14, MaxLoans: 2, MaxBalanceCents: 500},
+		{PatronType: policy.Any, ItemType: "reference", MaxLoans: 0},
+	}, nil)
+
+	cases := []struct {
+		name      string
+		itemType  string
+		openLoans int
+		balance   int64
+		reason    string
+	}{
+		{"Loan Limit Reached", policy.DefaultType, 2, 0, policy.ReasonMaxLoans},
+		{"Reference Only", "reference", 0, 0, policy.ReasonNotLoanable},
+		{"Fines Owed", policy.DefaultType, 0, 501, policy.ReasonBalanceOwed},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			mockPool := new(MockPgxPool)
+			mockTx := new(MockTx)
+			mockClaimRow := new(MockRow)
+
+			repo := NewBookRepository(&database.DB{Pool: mockPool})
+			ctx := context.Background()
+
+			// Expectations
+			mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+			expectBorrowerOwing(ctx, mockTx, policy.DefaultType, tc.openLoans, tc.balance)
+			expectNoReadyHold(ctx, mockTx)
+			mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockClaimRow).Once()
+			mockClaimRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+				dests := args.Get(0).([]interface{})
+				*(dests[0].(*string)) = "copy-id-1"
+				*(dests[1].(*string)) = "book-id-123"
+				*(dests[2].(*string)) = "BC-1"
+				*(dests[3].(*string)) = tc.itemType
+			}).Return(nil)
+			mockTx.On("Rollback", ctx).Return(nil).Once()
+
+			// Execute
+			result, err := repo.BorrowBook(ctx, "user-id-123", "book-id-123", "", rules)
+
+			// Verify
+			assert.Nil(t, result)
+			violation, ok := policy.AsViolation(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, tc.reason, violation.Reason)
+			}
+
+			// The copy claim is rolled back with the transaction
+			mockTx.AssertExpectations(t)
+			mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+		})
+	}
+}
+
+// TestBookRepository_ReturnBook tests the ReturnBook method
+func TestBookRepository_ReturnBook(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockBorrowRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Test data
+	borrowID := "borrow-id-123"
+	copyID := "copy-id-123"
+	returnedAt := time.Now()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. Close the borrow record, two and a half days late
+	expectReturnedBorrow(ctx, mockTx, mockBorrowRow, copyID, returnedAt.Add(-60*time.Hour), returnedAt)
+
+	// 2. The fine is charged to the borrower's account
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("INSERT 0 1"), nil).Once()
+
+	// 3. Nobody is waiting, so the copy goes back on the shelf
+	expectCopyLocked(ctx, mockTx, copyID)
+	mockHoldRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldRow).Once()
+	mockHoldRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Once()
+
+	// 4. Commit
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	holdExpiresAt := time.Now().Add(72 * time.Hour)
+	returned, err := repo.ReturnBook(ctx, borrowID, holdExpiresAt, testRules)
 
 	// Verify
 	assert.NoError(t, err)
-	assert.Equal(t, borrowID, result)
+	assert.Empty(t, returned.HoldID)
+	assert.Equal(t, 3, returned.DaysOverdue)
+	assert.Equal(t, int64(75), returned.FineCents)
+
+	returnArgsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, borrowID, returnArgsSlice[0])
+	chargeArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, borrowID, chargeArgsSlice[0])
+	assert.Equal(t, int64(75), chargeArgsSlice[1])
+	holdArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, copyID, holdArgsSlice[0])
+	assert.Equal(t, holdExpiresAt, holdArgsSlice[1])
+	availableArgsSlice := mockTx.Calls[4].Arguments[2].([]interface{})
+	assert.Equal(t, copyID, availableArgsSlice[0])
+	assert.Equal(t, "available", availableArgsSlice[1])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_ReturnBook_PoliciesInTransaction tests that the policy overrides are read
+// through the transaction holding the borrow, not from a second pool connection
+func TestBookRepository_ReturnBook_PoliciesInTransaction(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockBorrowRow := new(MockRow)
+	mockPolicyRows := new(MockRows)
 
-	// Verify correct parameters were passed for book availability check
-	availableArgsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
-	assert.Equal(t, bookID, availableArgsSlice[0])
+	db := &database.DB{Pool: mockPool}
+	repo := NewBookRepository(db)
+	rules := policy.NewEngine(policy.DefaultRules, NewPolicyRepository(db))
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. Close the borrow record, returned on time
+	expectReturnedBorrow(ctx, mockTx, mockBorrowRow, "copy-id-123", time.Now().Add(time.Hour), time.Now())
+
+	// 2. No overrides are stored
+	policySQL := mock.MatchedBy(func(sql string) bool { return strings.Contains(sql, "circulation_policies") })
+	mockTx.On("Query", ctx, policySQL, mock.Anything).Return(mockPolicyRows, nil).Once()
+	mockPolicyRows.On("Close").Return()
+
+	// 3. Nobody is waiting, so the copy goes back on the shelf
+	expectCopyLocked(ctx, mockTx, "copy-id-123")
+	mockHoldRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldRow).Once()
+	mockHoldRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	returned, err := repo.ReturnBook(ctx, "borrow-id-123", time.Now().Add(72*time.Hour), rules)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Zero(t, returned.FineCents)
+
+	mockPool.AssertExpectations(t)
+	mockPool.AssertNotCalled(t, "Query", mock.Anything, mock.Anything, mock.Anything)
+	mockTx.AssertExpectations(t)
+	mockPolicyRows.AssertExpectations(t)
+}
+
+// TestBookRepository_ReturnBook_AlreadyReturned tests ReturnBook on a closed borrow record
+func TestBookRepository_ReturnBook_AlreadyReturned(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockBorrowRow := new(MockRow)
+	mockExistsRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowRow).Once()
+	mockBorrowRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockExistsRow).Once()
+	mockExistsRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = true
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	_, err := repo.ReturnBook(ctx, "borrow-id-123", time.Now(), testRules)
+
+	// Verify
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "already been returned")
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestBookRepository_AddCopy tests that AddCopy fills in defaults for a new copy and shelves it
+// when nobody is waiting for the title
+func TestBookRepository_AddCopy(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+	mockHoldRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. Insert the copy
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow).Once()
+	mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-1"
+		*(dests[1].(*string)) = "book-id-123"
+		*(dests[2].(*string)) = "LIB-000000000001"
+		*(dests[3].(*string)) = DefaultBranch
+		*(dests[4].(*string)) = "good"
+		*(dests[5].(*string)) = "available"
+	}).Return(nil)
+
+	// 2. No hold is waiting, so the copy goes on the shelf
+	expectCopyLocked(ctx, mockTx, "copy-id-1")
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldRow).Once()
+	mockHoldRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	bookCopy, err := repo.AddCopy(ctx, &pb.BookCopy{BookId: "book-id-123"}, time.Now().Add(72*time.Hour))
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "copy-id-1", bookCopy.Id)
+	assert.Equal(t, pb.CopyStatus_COPY_STATUS_AVAILABLE, bookCopy.Status)
+
+	argsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, "book-id-123", argsSlice[0])
+	assert.Regexp(t, `^LIB-[0-9A-F]{12}$`, argsSlice[1])
+	assert.Equal(t, DefaultBranch, argsSlice[2])
+	assert.Equal(t, "good", argsSlice[3])
+	assert.Equal(t, "available", argsSlice[4])
+
+	copyArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, "copy-id-1", copyArgsSlice[0])
+	assert.Equal(t, "available", copyArgsSlice[1])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockRow.AssertExpectations(t)
+}
+
+// TestBookRepository_AddCopy_ConstraintViolations tests that violated constraints are reported as domain errors
+func TestBookRepository_AddCopy_ConstraintViolations(t *testing.T) {
+	cases := map[string]struct {
+		code string
+		want error
+		kind error
+	}{
+		"Duplicate Barcode": {"23505", ErrDuplicateBarcode, ErrDuplicate},
+		"Unknown Book":      {"23503", ErrBookNotFound, ErrNotFound},
+	}
+
+	for name, tc := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockTx := new(MockTx)
+		mockRow := new(MockRow)
+
+		repo := NewBookRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+		mockRow.On("Scan", mock.Anything).Return(&pgconn.PgError{Code: tc.code})
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		// Execute
+		bookCopy, err := repo.AddCopy(ctx, &pb.BookCopy{BookId: "book-id-123", Barcode: "LIB-1"}, time.Now())
+
+		// Verify
+		assert.Nil(t, bookCopy, name)
+		assert.ErrorIs(t, err, tc.want, name)
+		assert.ErrorIs(t, err, tc.kind, name)
+	}
+}
+
+// TestBookRepository_ListCopies_IterationError tests that an error ending the rows early is not
+// reported as a complete list
+func TestBookRepository_ListCopies_IterationError(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRows := &MockRows{err: errors.New("connection reset")}
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+	mockRows.On("Close").Return()
+
+	// Execute
+	_, err := repo.ListCopies(ctx, "book-id-123")
+
+	// Verify
+	assert.EqualError(t, err, "connection reset")
+	mockRows.AssertExpectations(t)
+}
+
+// TestBookRepository_UpdateCopy_OnLoan tests that a copy on loan keeps its status
+func TestBookRepository_UpdateCopy_OnLoan(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUpdateRow := new(MockRow)
+	mockExistsRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := NewBookRepository(db)
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUpdateRow).Once()
+	mockUpdateRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockExistsRow).Once()
+	mockExistsRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = true
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	bookCopy, err := repo.UpdateCopy(ctx, &pb.BookCopy{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_LOST}, time.Now())
+
+	// Verify
+	assert.Error(t, err)
+	assert.Nil(t, bookCopy)
+	assert.ErrorIs(t, err, ErrCopyInUse)
+
+	argsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, "copy-id-1", argsSlice[0])
+	assert.Equal(t, "", argsSlice[1])
+	assert.Equal(t, "", argsSlice[2])
+	assert.Equal(t, "lost", argsSlice[3])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// testRules resolves every loan to policy.DefaultRules
+var testRules = policy.NewEngine(policy.DefaultRules, nil)
+
+// expectBorrower expects BorrowBook to lock a borrower of the given type with openLoans loans
+// and nothing owed
+func expectBorrower(ctx context.Context, mockTx *MockTx, patronType string, openLoans int) {
+	expectBorrowerOwing(ctx, mockTx, patronType, openLoans, 0)
+}
+
+// expectBorrowerOwing expects BorrowBook to lock a verified borrower who owes balanceCents
+func expectBorrowerOwing(ctx context.Context, mockTx *MockTx, patronType string, openLoans int, balanceCents int64) {
+	mockBorrowerRow := new(MockRow)
+	mockBorrowerRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = patronType
+		*(dests[1].(*int)) = openLoans
+		*(dests[2].(*int64)) = balanceCents
+		*(dests[3].(*bool)) = true
+	}).Return(nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowerRow).Once()
+}
+
+// expectReturnedBorrow expects ReturnBook to close a borrow of copyID due at dueDate
+func expectReturnedBorrow(ctx context.Context, mockTx *MockTx, mockBorrowRow *MockRow, copyID string, dueDate, returnedAt time.Time) {
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowRow).Once()
+	mockBorrowRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = copyID
+		*(dests[1].(*time.Time)) = dueDate
+		*(dests[2].(*time.Time)) = returnedAt
+		*(dests[3].(*string)) = policy.DefaultType
+		*(dests[4].(*string)) = policy.DefaultType
+	}).Return(nil)
+}
+
+// expectNoReadyHold expects BorrowBook's lookup of a ready hold to find nothing
+func expectNoReadyHold(ctx context.Context, mockTx *MockTx) {
+	mockHoldRow := new(MockRow)
+	mockHoldRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldRow).Once()
+}
+
+// MockTx implements pgx.Tx for testing; methods not overridden here panic if called
+type MockTx struct {
+	mock.Mock
+	pgx.Tx
+}
+
+func (m *MockTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	callArgs := m.Called(ctx, sql, args)
+	return callArgs.Get(0).(pgconn.CommandTag), callArgs.Error(1)
+}
+
+func (m *MockTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	callArgs := m.Called(ctx, sql, args)
+	return callArgs.Get(0).(pgx.Rows), callArgs.Error(1)
+}
+
+func (m *MockTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	callArgs := m.Called(ctx, sql, args)
+	return callArgs.Get(0).(pgx.Row)
+}
+
+func (m *MockTx) Commit(ctx context.Context) error {
+	return m.Called(ctx).Error(0)
+}
 
+func (m *MockTx) Rollback(ctx context.Context) error {
+	return m.Called(ctx).Error(0)
 }
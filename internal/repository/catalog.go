@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	pb "library-management-service/proto/library/v1"
+)
+
+var (
+	ErrBookNotFound    = newError(ErrNotFound, "BOOK_NOT_FOUND", "book not found")
+	ErrVersionConflict = newError(ErrStale, "BOOK_VERSION_CONFLICT", "book was changed by someone else")
+	ErrBookOnLoan      = newError(ErrConflict, "BOOK_ON_LOAN", "book has copies on loan")
+)
+
+// bookFieldUpdates maps the Book fields that Update can write to the assignment setting their
+// column from a parameter, and the value of that parameter. Contributors are updated separately.
+var bookFieldUpdates = map[string]struct {
+	assignment string
+	value      func(*pb.Book) interface{}
+}{
+	"title":            {"title = %s", func(b *pb.Book) interface{} { return b.Title }},
+	"author":           {"author = %s", func(b *pb.Book) interface{} { return b.Author }},
+	"isbn":             {"isbn = NULLIF(%s, '')", func(b *pb.Book) interface{} { return b.Isbn }},
+	"description":      {"description = %s", func(b *pb.Book) interface{} { return b.Description }},
+	"subjects":         {"subjects = %s", func(b *pb.Book) interface{} { return nonNil(b.Subjects) }},
+	"publication_year": {"publication_year = NULLIF(%s, 0)", func(b *pb.Book) interface{} { return b.PublicationYear }},
+	"language":         {"language = %s", func(b *pb.Book) interface{} { return b.Language }},
+	"publisher":        {"publisher = %s", func(b *pb.Book) interface{} { return b.Publisher }},
+	"edition":          {"edition = %s", func(b *pb.Book) interface{} { return b.Edition }},
+	"page_count":       {"page_count = NULLIF(%s, 0)", func(b *pb.Book) interface{} { return b.PageCount }},
+	"cover_url":        {"cover_url = %s", func(b *pb.Book) interface{} { return b.CoverUrl }},
+}
+
+// Update writes the fields of book named by paths, provided that the stored book is still at
+// book.Version, and returns the updated book. Updated contributors replace the current ones.
+// As in Create, they default to the author, and the author is derived from them unless it is
+// updated too.
+func (r *BookRepository) Update(ctx context.Context, book *pb.Book, paths []string) (*pb.Book, error) {
+	replaceContributors := slices.Contains(paths, "contributors")
+	if replaceContributors {
+		if len(book.Contributors) == 0 {
+			book.Contributors = []*pb.Contributor{{Name: book.Author, Role: pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR}}
+		} else if !slices.Contains(paths, "author") {
+			book.Author = AuthorNames(book.Contributors)
+			paths = append(paths, "author")
+		}
+	}
+
+	assignments := []string{"version = version + 1", "updated_at = NOW()"}
+	args := []interface{}{book.Id, book.Version}
+	for _, path := range paths {
+		if path == "contributors" {
+			continue
+		}
+		field, ok := bookFieldUpdates[path]
+		if !ok {
+			return nil, fmt.Errorf("cannot update book field %q", path)
+		}
+		args = append(args, field.value(book))
+		assignments = append(assignments, fmt.Sprintf(field.assignment, fmt.Sprintf("$%d", len(args))))
+	}
+
+	var updated *pb.Book
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var id string
+		err := tx.QueryRow(ctx, `
+			UPDATE books SET `+strings.Join(assignments, ", ")+`
+			WHERE id = $1 AND version = $2 AND deleted_at IS NULL
+			RETURNING id
+		`, args...).Scan(&id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return staleBookError(ctx, tx, book.Id)
+		}
+		if err != nil {
+			if isUniqueViolation(err) {
+				return ErrDuplicateISBN
+			}
+			return fmt.Errorf("failed to update book: %w", err)
+		}
+
+		if replaceContributors {
+			if _, err := tx.Exec(ctx, "DELETE FROM book_contributors WHERE book_id = $1", book.Id); err != nil {
+				return fmt.Errorf("failed to remove contributors: %w", err)
+			}
+			if err := insertContributors(ctx, tx, book.Id, book.Contributors); err != nil {
+				return err
+			}
+		}
+
+		updated, err = scanBook(tx.QueryRow(ctx, selectBooks+" WHERE books.id = $1", book.Id))
+		if err != nil {
+			return fmt.Errorf("failed to get book: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Delete removes a book from the catalogue, provided that none of its copies is on loan and, when
+// version is not zero, that the stored book is still at version. The book's open holds are
+// cancelled and its copies withdrawn; the book itself is kept for the loans that refer to it.
+func (r *BookRepository) Delete(ctx context.Context, id string, version int32) error {
+	return r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var current int32
+		err := tx.QueryRow(ctx, `
+			SELECT version FROM books WHERE id = $1 AND deleted_at IS NULL FOR UPDATE
+		`, id).Scan(&current)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrBookNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get book: %w", err)
+		}
+		if version != 0 && version != current {
+			return ErrVersionConflict
+		}
+
+		// Lock the copies so that none can be borrowed while the book is deleted
+		var onLoan int
+		err = tx.QueryRow(ctx, `
+			SELECT COUNT(*) FILTER (WHERE status = 'on_loan')::int
+			FROM (SELECT status FROM book_copies WHERE book_id = $1 FOR UPDATE) copies
+		`, id).Scan(&onLoan)
+		if err != nil {
+			return fmt.Errorf("failed to check copies: %w", err)
+		}
+		if onLoan > 0 {
+			return fmt.Errorf("%w: %d must be returned first", ErrBookOnLoan, onLoan)
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE holds SET status = 'cancelled', updated_at = NOW()
+			WHERE book_id = $1 AND status IN ('waiting', 'ready')
+		`, id)
+		if err != nil {
+			return fmt.Errorf("failed to cancel holds: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE book_copies SET status = 'withdrawn', updated_at = NOW() WHERE book_id = $1", id)
+		if err != nil {
+			return fmt.Errorf("failed to withdraw copies: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE books SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+			WHERE id = $1
+		`, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete book: %w", err)
+		}
+		return nil
+	})
+}
+
+// staleBookError explains why a conditional update of a book matched no row
+func staleBookError(ctx context.Context, tx pgx.Tx, id string) error {
+	var exists bool
+	err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM books WHERE id = $1 AND deleted_at IS NULL)", id).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check book: %w", err)
+	}
+	if !exists {
+		return ErrBookNotFound
+	}
+	return ErrVersionConflict
+}
+
+// nonNil returns an empty slice for nil, as array columns are not nullable
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/database"
+	pb "library-management-service/proto/library/v1"
+)
+
+// expectCopyCounts expects the copies of a book to be locked and the ones on loan counted
+func expectCopyCounts(ctx context.Context, mockTx *MockTx, onLoan int) {
+	mockCountRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockCountRow).Once()
+	mockCountRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*int)) = onLoan
+	}).Return(nil)
+}
+
+// TestBookRepository_Update tests that only the masked fields are written, at the expected version
+func TestBookRepository_Update(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUpdateRow := new(MockRow)
+	mockBookRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Test data
+	book := &pb.Book{Id: "book-id-123", Title: "Dune Messiah", PageCount: 0, Version: 3}
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUpdateRow).Once()
+	mockUpdateRow.On("Scan", mock.Anything).Return(nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBookRow).Once()
+	mockBookRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "book-id-123"
+		*(dests[1].(*string)) = "Dune Messiah"
+		*(dests[18].(*int32)) = 4
+	}).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	updated, err := repo.Update(ctx, book, []string{"title", "page_count"})
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "Dune Messiah", updated.Title)
+	assert.Equal(t, int32(4), updated.Version)
+
+	query := mockTx.Calls[0].Arguments[1].(string)
+	assert.Contains(t, query, "version = version + 1, updated_at = NOW(), title = $3, page_count = NULLIF($4, 0)")
+	assert.Contains(t, query, "WHERE id = $1 AND version = $2 AND deleted_at IS NULL")
+	argsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"book-id-123", int32(3), "Dune Messiah", int32(0)}, argsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_Update_Contributors tests that new contributors replace the old ones and
+// rename the author
+func TestBookRepository_Update_Contributors(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Test data
+	book := &pb.Book{Id: "book-id-123", Version: 1, Contributors: []*pb.Contributor{
+		{Name: "Frank Herbert", Role: pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR},
+	}}
+
+	// Expectations: update, remove the old credits, add the new one, read back
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow).Times(3)
+	mockRow.On("Scan", mock.Anything).Return(nil)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("DELETE 2"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	_, err := repo.Update(ctx, book, []string{"contributors"})
+
+	// Verify
+	assert.NoError(t, err)
+
+	argsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"book-id-123", int32(1), "Frank Herbert"}, argsSlice)
+	contributorArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"book-id-123", "Frank Herbert", "author", 0}, contributorArgsSlice)
+
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_Update_VersionConflict tests that a stale version is reported as a conflict
+func TestBookRepository_Update_VersionConflict(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUpdateRow := new(MockRow)
+	mockExistsRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations: no row at version 3, but the book exists
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUpdateRow).Once()
+	mockUpdateRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockExistsRow).Once()
+	mockExistsRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = true
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	updated, err := repo.Update(ctx, &pb.Book{Id: "book-id-123", Title: "Dune", Version: 3}, []string{"title"})
+
+	// Verify
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	assert.Nil(t, updated)
+
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestBookRepository_Update_UnknownField tests that fields without a column are refused
+func TestBookRepository_Update_UnknownField(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Execute
+	updated, err := repo.Update(ctx, &pb.Book{Id: "book-id-123"}, []string{"total_copies"})
+
+	// Verify
+	assert.Error(t, err)
+	assert.Nil(t, updated)
+	mockPool.AssertNotCalled(t, "BeginTx", mock.Anything, mock.Anything)
+}
+
+// TestBookRepository_Delete tests that deleting a book cancels its holds and withdraws its copies
+func TestBookRepository_Delete(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockBookRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBookRow).Once()
+	mockBookRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*int32)) = 2
+	}).Return(nil)
+	expectCopyCounts(ctx, mockTx, 0)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Times(3)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	err := repo.Delete(ctx, "book-id-123", 2)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Contains(t, mockTx.Calls[2].Arguments[1].(string), "status = 'cancelled'")
+	assert.Contains(t, mockTx.Calls[3].Arguments[1].(string), "status = 'withdrawn'")
+	assert.Contains(t, mockTx.Calls[4].Arguments[1].(string), "deleted_at = NOW()")
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_Delete_Refused tests that stale versions and books on loan are not deleted
+func TestBookRepository_Delete_Refused(t *testing.T) {
+	cases := map[string]struct {
+		version int32
+		onLoan  int
+		err     error
+	}{
+		"Version Conflict": {version: 1, err: ErrVersionConflict},
+		"Copy On Loan":     {version: 2, onLoan: 1, err: ErrBookOnLoan},
+	}
+
+	for name, tc := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockTx := new(MockTx)
+		mockBookRow := new(MockRow)
+
+		repo := NewBookRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations: the stored book is at version 2
+		mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBookRow).Once()
+		mockBookRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*(args.Get(0).([]interface{})[0].(*int32)) = 2
+		}).Return(nil)
+		if tc.err == ErrBookOnLoan {
+			expectCopyCounts(ctx, mockTx, tc.onLoan)
+		}
+		mockTx.On("Rollback", ctx).Return(nil).Once()
+
+		// Execute
+		err := repo.Delete(ctx, "book-id-123", tc.version)
+
+		// Verify
+		assert.ErrorIs(t, err, tc.err, name)
+		mockTx.AssertExpectations(t)
+		mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+	}
+}
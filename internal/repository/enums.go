@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"strings"
+
+	pb "library-management-service/proto/library/v1"
+)
+
+// Enums are stored in the database as the lower-cased value name without its
+// type prefix, e.g. ROLE_LIBRARIAN is stored as "librarian".
+
+const (
+	rolePrefix          = "ROLE_"
+	copyStatusPrefix    = "COPY_STATUS_"
+	copyConditionPrefix = "COPY_CONDITION_"
+	holdStatusPrefix    = "HOLD_STATUS_"
+	ledgerKindPrefix    = "LEDGER_ENTRY_KIND_"
+	contributorPrefix   = "CONTRIBUTOR_ROLE_"
+	loanStatePrefix     = "LOAN_STATE_"
+)
+
+func enumName(prefix, value string) string {
+	return strings.ToLower(strings.TrimPrefix(value, prefix))
+}
+
+func enumValue(prefix, name string, values map[string]int32) int32 {
+	return values[prefix+strings.ToUpper(name)]
+}
+
+// RoleName returns the name a role is stored under in the database and in access tokens
+func RoleName(role pb.Role) string {
+	return enumName(rolePrefix, role.String())
+}
+
+// RoleFromName converts a stored role name to its protobuf value, returning ROLE_UNSPECIFIED for unknown names
+func RoleFromName(name string) pb.Role {
+	return pb.Role(enumValue(rolePrefix, name, pb.Role_value))
+}
+
+// RoleNames converts roles to their stored names
+func RoleNames(roles []pb.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, RoleName(role))
+	}
+	return names
+}
+
+func rolesFromNames(names []string) []pb.Role {
+	roles := make([]pb.Role, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, RoleFromName(name))
+	}
+	return roles
+}
+
+// CopyStatusName returns the name a copy status is stored under
+func CopyStatusName(status pb.CopyStatus) string {
+	return enumName(copyStatusPrefix, status.String())
+}
+
+// CopyStatusFromName converts a stored copy status to its protobuf value
+func CopyStatusFromName(name string) pb.CopyStatus {
+	return pb.CopyStatus(enumValue(copyStatusPrefix, name, pb.CopyStatus_value))
+}
+
+// CopyConditionName returns the name a copy condition is stored under
+func CopyConditionName(condition pb.CopyCondition) string {
+	return enumName(copyConditionPrefix, condition.String())
+}
+
+// CopyConditionFromName converts a stored copy condition to its protobuf value
+func CopyConditionFromName(name string) pb.CopyCondition {
+	return pb.CopyCondition(enumValue(copyConditionPrefix, name, pb.CopyCondition_value))
+}
+
+// HoldStatusName returns the name a hold status is stored under
+func HoldStatusName(status pb.HoldStatus) string {
+	return enumName(holdStatusPrefix, status.String())
+}
+
+// HoldStatusFromName converts a stored hold status to its protobuf value
+func HoldStatusFromName(name string) pb.HoldStatus {
+	return pb.HoldStatus(enumValue(holdStatusPrefix, name, pb.HoldStatus_value))
+}
+
+// LedgerEntryKindName returns the name a ledger entry kind is stored under
+func LedgerEntryKindName(kind pb.LedgerEntryKind) string {
+	return enumName(ledgerKindPrefix, kind.String())
+}
+
+// LedgerEntryKindFromName converts a stored ledger entry kind to its protobuf value
+func LedgerEntryKindFromName(name string) pb.LedgerEntryKind {
+	return pb.LedgerEntryKind(enumValue(ledgerKindPrefix, name, pb.LedgerEntryKind_value))
+}
+
+// ContributorRoleName returns the name a contributor role is stored under
+func ContributorRoleName(role pb.ContributorRole) string {
+	return enumName(contributorPrefix, role.String())
+}
+
+// ContributorRoleFromName converts a stored contributor role to its protobuf value
+func ContributorRoleFromName(name string) pb.ContributorRole {
+	return pb.ContributorRole(enumValue(contributorPrefix, name, pb.ContributorRole_value))
+}
+
+// LoanStateName returns the name of a loan state, as used to filter loans in REST queries
+func LoanStateName(state pb.LoanState) string {
+	return enumName(loanStatePrefix, state.String())
+}
+
+// LoanStateFromName converts a loan state name to its protobuf value
+func LoanStateFromName(name string) pb.LoanState {
+	return pb.LoanState(enumValue(loanStatePrefix, name, pb.LoanState_value))
+}
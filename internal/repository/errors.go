@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/jackc/pgconn"
+)
+
+// Domain identifies the reasons of domain errors in gRPC error details
+const Domain = "library"
+
+// Kinds of domain errors. Every failure a caller can act on is reported with an *Error of one
+// of these kinds, so that callers can handle a whole family of failures with errors.Is. Any other
+// error returned by a repository is a failure of the database itself.
+var (
+	// ErrNotFound means that the record acted upon does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrDuplicate means that a record with the same unique value already exists
+	ErrDuplicate = errors.New("already exists")
+	// ErrConflict means that the record is not in a state that allows the change
+	ErrConflict = errors.New("conflict")
+	// ErrUnavailable means that there is nothing that can be lent right now
+	ErrUnavailable = errors.New("unavailable")
+	// ErrStale means that the record was changed by someone else since it was read
+	ErrStale = errors.New("stale")
+	// ErrInvalid means that a value given by the caller does not refer to anything usable
+	ErrInvalid = errors.New("invalid")
+	// ErrUnauthenticated means that credentials or a token were refused
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrForbidden means that the caller proved to lack the right to make the change
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited means that the change was made too recently to be made again
+	ErrRateLimited = errors.New("rate limited")
+)
+
+// Error is a domain error. Its message is fit to show to clients, and its reason identifies it
+// to programs.
+type Error struct {
+	kind    error
+	Reason  string
+	message string
+}
+
+func newError(kind error, reason, message string) error {
+	return &Error{kind: kind, Reason: reason, message: message}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Unwrap returns the kind of the error
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+// Kind returns the kind of the error
+func (e *Error) Kind() error {
+	return e.kind
+}
+
+// SQLSTATEs of the constraint violations that are reported as domain errors
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// isUniqueViolation reports whether err is a unique constraint violation
+func isUniqueViolation(err error) bool {
+	return hasSQLState(err, uniqueViolation)
+}
+
+// isForeignKeyViolation reports whether err is a foreign key constraint violation
+func isForeignKeyViolation(err error) bool {
+	return hasSQLState(err, foreignKeyViolation)
+}
+
+func hasSQLState(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
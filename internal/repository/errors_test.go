@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestError tests that domain errors keep their message and reason and match their kind
+func TestError(t *testing.T) {
+	err := fmt.Errorf("%w: %d must be returned first", ErrBookOnLoan, 2)
+
+	var domainErr *Error
+	assert.True(t, errors.As(err, &domainErr))
+	assert.Equal(t, "BOOK_ON_LOAN", domainErr.Reason)
+	assert.Equal(t, ErrConflict, domainErr.Kind())
+	assert.Equal(t, "book has copies on loan: 2 must be returned first", err.Error())
+
+	assert.ErrorIs(t, err, ErrBookOnLoan)
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.NotErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, ErrBookNotFound, ErrUserNotFound)
+}
@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	pb "library-management-service/proto/library/v1"
+)
+
+var (
+	ErrHoldNotFound  = newError(ErrNotFound, "HOLD_NOT_FOUND", "hold not found")
+	ErrHoldNotNeeded = newError(ErrConflict, "HOLD_NOT_NEEDED", "book has available copies")
+	ErrDuplicateHold = newError(ErrDuplicate, "DUPLICATE_HOLD", "hold already placed for this book")
+	ErrHoldClosed    = newError(ErrConflict, "HOLD_CLOSED", "hold is no longer active")
+)
+
+// selectHolds selects holds together with the queue position of waiting ones
+const selectHolds = `
+	SELECT holds.id, holds.book_id, holds.user_id, COALESCE(holds.copy_id::text, ''), holds.status,
+		holds.placed_at, holds.ready_at, holds.expires_at,
+		CASE WHEN holds.status = 'waiting' THEN (
+			SELECT COUNT(*) FROM holds ahead
+			WHERE ahead.book_id = holds.book_id AND ahead.status = 'waiting'
+				AND (ahead.placed_at, ahead.id) <= (holds.placed_at, holds.id)
+		)::int ELSE 0 END
+	FROM holds`
+
+// PlaceHold queues the user for the next returned copy of a title that has no copy available
+func (r *BookRepository) PlaceHold(ctx context.Context, userID, bookID string) (*pb.Hold, error) {
+	var hold *pb.Hold
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Locking the copies waits for a return in progress, whose copy would otherwise be
+		// shelved without seeing this hold; releaseCopy locks the copy before reading the queue
+		var available int
+		err := tx.QueryRow(ctx, `
+			SELECT COUNT(*) FILTER (WHERE status = 'available')
+			FROM (SELECT status FROM book_copies WHERE book_id = $1 FOR SHARE) copies
+		`, bookID).Scan(&available)
+		if err != nil {
+			return fmt.Errorf("failed to check availability: %w", err)
+		}
+		if available > 0 {
+			return ErrHoldNotNeeded
+		}
+
+		// The partial unique index turns a second active hold into a no-op
+		var holdID string
+		err = tx.QueryRow(ctx, `
+			INSERT INTO holds (book_id, user_id)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+			RETURNING id
+		`, bookID, userID).Scan(&holdID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrDuplicateHold
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create hold: %w", err)
+		}
+
+		hold, err = scanHold(tx.QueryRow(ctx, selectHolds+" WHERE holds.id = $1", holdID))
+		if err != nil {
+			return fmt.Errorf("failed to get hold: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (r *BookRepository) GetHold(ctx context.Context, id string) (*pb.Hold, error) {
+	hold, err := scanHold(r.db.Pool.QueryRow(ctx, selectHolds+" WHERE holds.id = $1", id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return hold, nil
+}
+
+// CancelHold closes a waiting or ready hold. A copy set aside for a ready hold passes to
+// the next hold in the queue, which can pick it up until holdExpiresAt.
+func (r *BookRepository) CancelHold(ctx context.Context, id string, holdExpiresAt time.Time) (*pb.Hold, error) {
+	var hold *pb.Hold
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var status, copyID string
+		err := tx.QueryRow(ctx, `
+			SELECT status, COALESCE(copy_id::text, '') FROM holds WHERE id = $1 FOR UPDATE
+		`, id).Scan(&status, &copyID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrHoldNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get hold: %w", err)
+		}
+		if status != "waiting" && status != "ready" {
+			return ErrHoldClosed
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE holds SET status = 'cancelled', updated_at = NOW() WHERE id = $1", id)
+		if err != nil {
+			return fmt.Errorf("failed to cancel hold: %w", err)
+		}
+		if status == "ready" && copyID != "" {
+			if _, err := releaseCopy(ctx, tx, copyID, holdExpiresAt); err != nil {
+				return err
+			}
+		}
+
+		hold, err = scanHold(tx.QueryRow(ctx, selectHolds+" WHERE holds.id = $1", id))
+		if err != nil {
+			return fmt.Errorf("failed to get hold: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// ListHolds returns holds in queue order, optionally filtered by title and user
+func (r *BookRepository) ListHolds(ctx context.Context, bookID, userID string, includeClosed bool) ([]*pb.Hold, error) {
+	rows, err := r.db.Pool.Query(ctx, selectHolds+`
+		WHERE ($1 = '' OR holds.book_id::text = $1)
+			AND ($2 = '' OR holds.user_id::text = $2)
+			AND ($3 OR holds.status IN ('waiting', 'ready'))
+		ORDER BY holds.placed_at, holds.id
+	`, bookID, userID, includeClosed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holds: %w", err)
+	}
+	defer rows.Close()
+
+	var holds []*pb.Hold
+	for rows.Next() {
+		hold, err := scanHold(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan hold: %w", err)
+		}
+		holds = append(holds, hold)
+	}
+
+	return holds, rows.Err()
+}
+
+// ExpireHolds closes ready holds whose pickup window has passed and passes their copies on.
+// It returns the number of holds expired.
+func (r *BookRepository) ExpireHolds(ctx context.Context, holdExpiresAt time.Time) (int, error) {
+	expired := 0
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			UPDATE holds SET status = 'expired', updated_at = NOW()
+			WHERE status = 'ready' AND expires_at <= NOW()
+			RETURNING copy_id
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to expire holds: %w", err)
+		}
+
+		var copyIDs []string
+		for rows.Next() {
+			var copyID string
+			if err := rows.Scan(&copyID); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan expired hold: %w", err)
+			}
+			copyIDs = append(copyIDs, copyID)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to expire holds: %w", err)
+		}
+
+		for _, copyID := range copyIDs {
+			if _, err := releaseCopy(ctx, tx, copyID, holdExpiresAt); err != nil {
+				return err
+			}
+		}
+		expired = len(copyIDs)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return expired, nil
+}
+
+// releaseCopy sets a copy aside for the oldest waiting hold on its title, or puts it back
+// on the shelf when nobody is waiting. It returns the id of the hold that became ready, if any.
+func releaseCopy(ctx context.Context, tx pgx.Tx, copyID string, holdExpiresAt time.Time) (string, error) {
+	// Lock the copy first, so that a hold placed while the title had no copy available is
+	// committed, and seen by the queue lookup, before the copy can be shelved
+	_, err := tx.Exec(ctx, "SELECT 1 FROM book_copies WHERE id = $1 FOR NO KEY UPDATE", copyID)
+	if err != nil {
+		return "", fmt.Errorf("failed to lock copy: %w", err)
+	}
+
+	var holdID string
+	err = tx.QueryRow(ctx, `
+		UPDATE holds SET status = 'ready', copy_id = $1, ready_at = NOW(), expires_at = $2, updated_at = NOW()
+		WHERE id = (
+			SELECT holds.id FROM holds
+			JOIN book_copies ON book_copies.book_id = holds.book_id
+			WHERE book_copies.id = $1 AND holds.status = 'waiting'
+			ORDER BY holds.placed_at, holds.id
+			LIMIT 1
+			FOR UPDATE OF holds SKIP LOCKED
+		)
+		RETURNING id
+	`, copyID, holdExpiresAt).Scan(&holdID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("failed to assign copy to hold: %w", err)
+	}
+
+	status := "available"
+	if holdID != "" {
+		status = "on_hold"
+	}
+	_, err = tx.Exec(ctx, "UPDATE book_copies SET status = $2, updated_at = NOW() WHERE id = $1", copyID, status)
+	if err != nil {
+		return "", fmt.Errorf("failed to update copy availability: %w", err)
+	}
+
+	return holdID, nil
+}
+
+// offerCopy passes a copy that has become available to releaseCopy, so that it is set aside for
+// a waiting hold before anyone can borrow it off the shelf
+func offerCopy(ctx context.Context, tx pgx.Tx, bookCopy *pb.BookCopy, holdExpiresAt time.Time) error {
+	if bookCopy.Status != pb.CopyStatus_COPY_STATUS_AVAILABLE {
+		return nil
+	}
+	holdID, err := releaseCopy(ctx, tx, bookCopy.Id, holdExpiresAt)
+	if err != nil {
+		return err
+	}
+	if holdID != "" {
+		bookCopy.Status = pb.CopyStatus_COPY_STATUS_ON_HOLD
+	}
+	return nil
+}
+
+func scanHold(row pgx.Row) (*pb.Hold, error) {
+	var hold pb.Hold
+	var status string
+	var placedAt time.Time
+	var readyAt, expiresAt *time.Time
+	err := row.Scan(&hold.Id, &hold.BookId, &hold.UserId, &hold.CopyId, &status,
+		&placedAt, &readyAt, &expiresAt, &hold.QueuePosition)
+	if err != nil {
+		return nil, err
+	}
+	hold.Status = HoldStatusFromName(status)
+	hold.PlacedAt = placedAt.Format(time.RFC3339)
+	if readyAt != nil {
+		hold.ReadyAt = readyAt.Format(time.RFC3339)
+	}
+	if expiresAt != nil {
+		hold.ExpiresAt = expiresAt.Format(time.RFC3339)
+	}
+	return &hold, nil
+}
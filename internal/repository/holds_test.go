@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/database"
+	pb "library-management-service/proto/library/v1"
+)
+
+// TestBookRepository_PlaceHold_NotNeeded tests that titles with an available copy cannot be held
+func TestBookRepository_PlaceHold_NotNeeded(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockCountRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockCountRow).Once()
+	mockCountRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*int)) = 1
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	hold, err := repo.PlaceHold(ctx, "user-id-123", "book-id-123")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrHoldNotNeeded)
+	assert.Nil(t, hold)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestBookRepository_PlaceHold_Duplicate tests that a user queues for a title only once
+func TestBookRepository_PlaceHold_Duplicate(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockCountRow := new(MockRow)
+	mockInsertRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockCountRow).Once()
+	mockCountRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*int)) = 0
+	}).Return(nil)
+
+	// ON CONFLICT DO NOTHING returns no row
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockInsertRow).Once()
+	mockInsertRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	hold, err := repo.PlaceHold(ctx, "user-id-123", "book-id-123")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrDuplicateHold)
+	assert.Nil(t, hold)
+
+	insertArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, "book-id-123", insertArgsSlice[0])
+	assert.Equal(t, "user-id-123", insertArgsSlice[1])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_PlaceHold_LocksCopies tests that the availability check locks the copies of
+// the title, so that it waits for a return in progress instead of missing its copy
+func TestBookRepository_PlaceHold_LocksCopies(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockCountRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations - the returned copy is on the shelf once the lock is granted
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{"book-id-123"}).Return(mockCountRow).Once()
+	mockCountRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*int)) = 1
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	_, err := repo.PlaceHold(ctx, "user-id-123", "book-id-123")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrHoldNotNeeded)
+	assert.Contains(t, mockTx.Calls[0].Arguments[1], "FOR SHARE")
+
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_ReturnBook_AssignsHold tests that a returned copy is set aside for the next hold
+func TestBookRepository_ReturnBook_AssignsHold(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockBorrowRow := new(MockRow)
+	mockHoldRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+	holdExpiresAt := time.Now().Add(72 * time.Hour)
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. Close the borrow record, returned on time
+	expectReturnedBorrow(ctx, mockTx, mockBorrowRow, "copy-id-123", time.Now().Add(time.Hour), time.Now())
+
+	// 2. The oldest waiting hold becomes ready
+	expectCopyLocked(ctx, mockTx, "copy-id-123")
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldRow).Once()
+	mockHoldRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = "hold-id-1"
+	}).Return(nil)
+
+	// 3. The copy is set aside rather than shelved
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	returned, err := repo.ReturnBook(ctx, "borrow-id-123", holdExpiresAt, testRules)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "hold-id-1", returned.HoldID)
+	assert.Zero(t, returned.FineCents)
+
+	assert.Contains(t, mockTx.Calls[1].Arguments[1], "FOR NO KEY UPDATE", "the copy is locked before the queue is read")
+	copyArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, "copy-id-123", copyArgsSlice[0])
+	assert.Equal(t, "on_hold", copyArgsSlice[1])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_UpdateCopy_AssignsHold tests that a copy back from maintenance is set aside
+// for the next hold instead of going on the shelf
+func TestBookRepository_UpdateCopy_AssignsHold(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUpdateRow := new(MockRow)
+	mockHoldRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+	holdExpiresAt := time.Now().Add(72 * time.Hour)
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. The copy leaves maintenance
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUpdateRow).Once()
+	mockUpdateRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-123"
+		*(dests[1].(*string)) = "book-id-123"
+		*(dests[2].(*string)) = "LIB-1"
+		*(dests[3].(*string)) = DefaultBranch
+		*(dests[4].(*string)) = "good"
+		*(dests[5].(*string)) = "available"
+	}).Return(nil)
+
+	// 2. The oldest waiting hold becomes ready
+	expectCopyLocked(ctx, mockTx, "copy-id-123")
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldRow).Once()
+	mockHoldRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = "hold-id-1"
+	}).Return(nil)
+
+	// 3. The copy is set aside rather than shelved
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	bookCopy, err := repo.UpdateCopy(ctx, &pb.BookCopy{Id: "copy-id-123", Status: pb.CopyStatus_COPY_STATUS_AVAILABLE}, holdExpiresAt)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, pb.CopyStatus_COPY_STATUS_ON_HOLD, bookCopy.Status)
+
+	holdArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, "copy-id-123", holdArgsSlice[0])
+	assert.Equal(t, holdExpiresAt, holdArgsSlice[1])
+	copyArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, "on_hold", copyArgsSlice[1])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_UpdateCopy_KeepsStatus tests that editing other fields of a copy leaves
+// the hold queue alone
+func TestBookRepository_UpdateCopy_KeepsStatus(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUpdateRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUpdateRow).Once()
+	mockUpdateRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-123"
+		*(dests[5].(*string)) = "available"
+	}).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	bookCopy, err := repo.UpdateCopy(ctx, &pb.BookCopy{Id: "copy-id-123", Branch: "east"}, time.Now())
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, pb.CopyStatus_COPY_STATUS_AVAILABLE, bookCopy.Status)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// expectCopyLocked expects releaseCopy to lock the copy before it reads the hold queue
+func expectCopyLocked(ctx context.Context, mockTx *MockTx, copyID string) {
+	lockSQL := mock.MatchedBy(func(sql string) bool { return strings.Contains(sql, "FOR NO KEY UPDATE") })
+	mockTx.On("Exec", ctx, lockSQL, []interface{}{copyID}).Return(pgconn.CommandTag("SELECT 1"), nil).Once()
+}
+
+// TestBookRepository_BorrowBook_FulfilsHold tests that collecting a ready hold lends the copy set aside
+func TestBookRepository_BorrowBook_FulfilsHold(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockHoldRow := new(MockRow)
+	mockClaimRow := new(MockRow)
+	mockBorrowRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrower(ctx, mockTx, "standard", 0)
+
+	// 1. The user's ready hold is fulfilled
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldRow).Once()
+	mockHoldRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = "copy-id-held"
+	}).Return(nil)
+
+	// 2. The held copy moves from on hold to on loan
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockClaimRow).Once()
+	mockClaimRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "copy-id-held"
+		*(dests[1].(*string)) = "book-id-123"
+		*(dests[2].(*string)) = "BC-9"
+	}).Return(nil)
+
+	// 3. Create borrow record
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowRow).Once()
+	mockBorrowRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = "borrow-id-123"
+	}).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	borrow, err := repo.BorrowBook(ctx, "user-id-123", "book-id-123", "", testRules)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "copy-id-held", borrow.CopyID)
+	assert.Equal(t, "BC-9", borrow.Barcode)
+
+	holdArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "book-id-123", ""}, holdArgsSlice)
+	claimArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, "copy-id-held", claimArgsSlice[0])
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_CancelHold_Closed tests that closed holds cannot be cancelled again
+func TestBookRepository_CancelHold_Closed(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockStatusRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockStatusRow).Once()
+	mockStatusRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "fulfilled"
+		*(dests[1].(*string)) = "copy-id-123"
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	hold, err := repo.CancelHold(ctx, "hold-id-1", time.Now())
+
+	// Verify
+	assert.ErrorIs(t, err, ErrHoldClosed)
+	assert.Nil(t, hold)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHoldStatusNames(t *testing.T) {
+	assert.Equal(t, "waiting", HoldStatusName(pb.HoldStatus_HOLD_STATUS_WAITING))
+	assert.Equal(t, pb.HoldStatus_HOLD_STATUS_READY, HoldStatusFromName("ready"))
+	assert.Equal(t, pb.HoldStatus_HOLD_STATUS_UNSPECIFIED, HoldStatusFromName("bogus"))
+}
@@ -0,0 +1,221 @@
+//go:build integration
+
+// The tests in this file run against a real PostgreSQL database, since the locking they check
+// cannot be reproduced with mocks. They are built with the integration tag and need the
+// connection string of a disposable database:
+//
+//	LIBRARY_TEST_DATABASE_URL=postgres://... go test -tags integration ./internal/repository/
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"library-management-service/internal/database"
+	"library-management-service/internal/policy"
+	pb "library-management-service/proto/library/v1"
+)
+
+// openTestDB connects to the test database and applies the migrations
+func openTestDB(t *testing.T) *database.DB {
+	connString := os.Getenv("LIBRARY_TEST_DATABASE_URL")
+	if connString == "" {
+		t.Fatal("LIBRARY_TEST_DATABASE_URL must name the database of the integration tests")
+	}
+
+	db, err := database.NewDB(context.Background(), connString)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(db.Close)
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+// openSingleConnDB connects to the migrated test database through a pool of one connection, on
+// which a query that needs a second connection while the first is held never completes
+func openSingleConnDB(t *testing.T) *database.DB {
+	config, err := pgxpool.ParseConfig(os.Getenv("LIBRARY_TEST_DATABASE_URL"))
+	if err != nil {
+		t.Fatalf("failed to parse connection string: %v", err)
+	}
+	config.MaxConns = 1
+
+	pool, err := pgxpool.ConnectConfig(context.Background(), config)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return &database.DB{Pool: pool}
+}
+
+// createVerifiedUsers registers n users whose email is verified, so that they may borrow
+func createVerifiedUsers(t *testing.T, db *database.DB, prefix string, n int) []string {
+	ctx := context.Background()
+	users := NewUserRepository(db)
+
+	userIDs := make([]string, n)
+	for i := range userIDs {
+		user, err := users.Create(ctx, "Racer", fmt.Sprintf("%s-%d@example.com", prefix, i), "password123")
+		if err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		if _, err := db.Pool.Exec(ctx, "UPDATE users SET email_verified = TRUE WHERE id = $1", user.Id); err != nil {
+			t.Fatalf("failed to verify user: %v", err)
+		}
+		userIDs[i] = user.Id
+	}
+	return userIDs
+}
+
+// TestBookRepository_BorrowBook_ConcurrentPostgres tests that the only copy of a title goes to
+// exactly one of many simultaneous borrowers
+func TestBookRepository_BorrowBook_ConcurrentPostgres(t *testing.T) {
+	const borrowers = 20
+
+	db := openTestDB(t)
+	ctx := context.Background()
+	books := NewBookRepository(db)
+
+	suffix := time.Now().UnixNano()
+	book, _, err := books.Create(ctx, &pb.Book{Title: "Race", Author: "Tester", Isbn: fmt.Sprintf("race-%d", suffix)}, nil)
+	if err != nil {
+		t.Fatalf("failed to create book: %v", err)
+	}
+	userIDs := createVerifiedUsers(t, db, fmt.Sprintf("racer-%d", suffix), borrowers)
+
+	var wg sync.WaitGroup
+	var succeeded int32
+	start := make(chan struct{})
+	for _, userID := range userIDs {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+			<-start
+			if _, err := books.BorrowBook(ctx, userID, book.Id, "", testRules); err == nil {
+				atomic.AddInt32(&succeeded, 1)
+			}
+		}(userID)
+	}
+	close(start)
+	wg.Wait()
+
+	var records int
+	err = db.Pool.QueryRow(ctx, "SELECT COUNT(*) FROM borrows WHERE book_id = $1", book.Id).Scan(&records)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), succeeded)
+	assert.Equal(t, 1, records)
+}
+
+// TestBookRepository_PlaceHold_ConcurrentReturn tests that a hold placed while the only copy is
+// being returned either picks the copy up or is refused, and never waits while it is shelved
+func TestBookRepository_PlaceHold_ConcurrentReturn(t *testing.T) {
+	const rounds = 20
+
+	db := openTestDB(t)
+	ctx := context.Background()
+	books := NewBookRepository(db)
+
+	suffix := time.Now().UnixNano()
+	userIDs := createVerifiedUsers(t, db, fmt.Sprintf("holder-%d", suffix), 2)
+	borrower, holder := userIDs[0], userIDs[1]
+
+	for round := 0; round < rounds; round++ {
+		book, copies, err := books.Create(ctx, &pb.Book{Title: "Race", Author: "Tester", Isbn: fmt.Sprintf("hold-%d-%d", suffix, round)}, nil)
+		if err != nil {
+			t.Fatalf("failed to create book: %v", err)
+		}
+		borrow, err := books.BorrowBook(ctx, borrower, book.Id, "", testRules)
+		if err != nil {
+			t.Fatalf("failed to borrow: %v", err)
+		}
+
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			<-start
+			if _, err := books.ReturnBook(ctx, borrow.ID, time.Now().Add(time.Hour), testRules); err != nil {
+				t.Errorf("failed to return: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			<-start
+			_, _ = books.PlaceHold(ctx, holder, book.Id)
+		}()
+		close(start)
+		wg.Wait()
+
+		var copyStatus string
+		var waiting int
+		err = db.Pool.QueryRow(ctx, `
+			SELECT status, (SELECT COUNT(*) FROM holds WHERE book_id = $2 AND status = 'waiting')
+			FROM book_copies WHERE id = $1
+		`, copies[0].Id, book.Id).Scan(&copyStatus, &waiting)
+		if err != nil {
+			t.Fatalf("failed to read copy: %v", err)
+		}
+		if copyStatus == "available" && waiting > 0 {
+			t.Fatalf("round %d: a hold is waiting while the copy is on the shelf", round)
+		}
+	}
+}
+
+// TestBookRepository_Circulation_SingleConnection tests that borrows, renewals and returns
+// resolve their circulation rules, overrides included, without a second pool connection
+func TestBookRepository_Circulation_SingleConnection(t *testing.T) {
+	const borrowers = 5
+
+	openTestDB(t)
+	db := openSingleConnDB(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	books := NewBookRepository(db)
+	rules := policy.NewEngine(policy.DefaultRules, NewPolicyRepository(db))
+
+	suffix := time.Now().UnixNano()
+	copies := make([]*pb.BookCopy, borrowers)
+	for i := range copies {
+		copies[i] = &pb.BookCopy{}
+	}
+	book, _, err := books.Create(ctx, &pb.Book{Title: "Queue", Author: "Tester", Isbn: fmt.Sprintf("single-%d", suffix)}, copies)
+	if err != nil {
+		t.Fatalf("failed to create book: %v", err)
+	}
+	userIDs := createVerifiedUsers(t, db, fmt.Sprintf("single-%d", suffix), borrowers)
+
+	var wg sync.WaitGroup
+	for _, userID := range userIDs {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+			borrow, err := books.BorrowBook(ctx, userID, book.Id, "", rules)
+			if err != nil {
+				t.Errorf("failed to borrow: %v", err)
+				return
+			}
+			if _, err := books.RenewLoan(ctx, borrow.ID, userID, rules); err != nil {
+				t.Errorf("failed to renew: %v", err)
+			}
+			if _, err := books.ReturnBook(ctx, borrow.ID, time.Now().Add(time.Hour), rules); err != nil {
+				t.Errorf("failed to return: %v", err)
+			}
+		}(userID)
+	}
+	wg.Wait()
+}
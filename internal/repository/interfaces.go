@@ -2,20 +2,58 @@ package repository
 
 import (
 	"context"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/policy"
 	pb "library-management-service/proto/library/v1"
 	"time"
 )
 
 type BookRepositoryInterface interface {
-	Create(ctx context.Context, book *pb.Book) (*pb.Book, error)
+	Create(ctx context.Context, book *pb.Book, copies []*pb.BookCopy) (*pb.Book, []*pb.BookCopy, error)
 	GetByID(ctx context.Context, id string) (*pb.Book, error)
-	List(ctx context.Context, limit, offset int32) ([]*pb.Book, error)
-	BorrowBook(ctx context.Context, userID, bookID string, dueDate time.Time) (string, error)
-	ReturnBook(ctx context.Context, borrowID string) error
+	List(ctx context.Context, limit int32, after *pagination.Cursor) ([]*pb.Book, error)
+	Count(ctx context.Context) (int32, error)
+	Search(ctx context.Context, search BookSearch, limit int32, after *pagination.Cursor) ([]*pb.BookSearchResult, error)
+	Update(ctx context.Context, book *pb.Book, paths []string) (*pb.Book, error)
+	Delete(ctx context.Context, id string, version int32) error
+	BorrowBook(ctx context.Context, userID, bookID, copyID string, rules policy.Resolver) (*Borrow, error)
+	ReturnBook(ctx context.Context, borrowID string, holdExpiresAt time.Time, rules policy.Resolver) (*Return, error)
+	GetBorrowerID(ctx context.Context, borrowID string) (string, error)
+	AddCopy(ctx context.Context, copy *pb.BookCopy, holdExpiresAt time.Time) (*pb.BookCopy, error)
+	ListCopies(ctx context.Context, bookID string) ([]*pb.BookCopy, error)
+	UpdateCopy(ctx context.Context, copy *pb.BookCopy, holdExpiresAt time.Time) (*pb.BookCopy, error)
+	PlaceHold(ctx context.Context, userID, bookID string) (*pb.Hold, error)
+	GetHold(ctx context.Context, id string) (*pb.Hold, error)
+	CancelHold(ctx context.Context, id string, holdExpiresAt time.Time) (*pb.Hold, error)
+	ListHolds(ctx context.Context, bookID, userID string, includeClosed bool) ([]*pb.Hold, error)
+	ExpireHolds(ctx context.Context, holdExpiresAt time.Time) (int, error)
+	RenewLoan(ctx context.Context, borrowID, renewedBy string, rules policy.Resolver) (*Renewal, error)
+	ListLoans(ctx context.Context, filter LoanFilter, limit int32, after *pagination.Cursor) ([]*pb.Loan, error)
 }
 
 type UserRepositoryInterface interface {
 	Create(ctx context.Context, name, email, password string) (*pb.User, error)
 	VerifyCredentials(ctx context.Context, email, password string) (*pb.User, error)
 	GetByID(ctx context.Context, id string) (*pb.User, error)
+	UpdateProfile(ctx context.Context, userID, name, email string) (*pb.User, error)
+	ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error
+	Deactivate(ctx context.Context, userID string) (*pb.User, error)
+	List(ctx context.Context, query string, includeDeactivated bool, limit int32, after *pagination.Cursor) ([]*pb.User, error)
+	CreatePasswordReset(ctx context.Context, email, token string, expiresAt time.Time, interval time.Duration) (*pb.User, error)
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	CreateEmailVerification(ctx context.Context, userID, token string, expiresAt time.Time, interval time.Duration) (*pb.User, error)
+	VerifyEmail(ctx context.Context, token string) (*pb.User, error)
+	CreateSession(ctx context.Context, userID, token string, expiresAt time.Time) error
+	RotateSession(ctx context.Context, token, newToken string, expiresAt time.Time) (*pb.User, error)
+	RevokeSession(ctx context.Context, token string, allSessions bool) error
+	LoginLockedUntil(ctx context.Context, keys ...string) (time.Time, error)
+	RecordLoginFailure(ctx context.Context, key string, lockout LoginLockout) (time.Time, error)
+	ClearLoginFailures(ctx context.Context, keys ...string) error
+	GrantRole(ctx context.Context, userID string, role pb.Role) (*pb.User, error)
+	RevokeRole(ctx context.Context, userID string, role pb.Role) (*pb.User, error)
+	SetPatronType(ctx context.Context, userID, patronType string) (*pb.User, error)
+	GetBalance(ctx context.Context, userID string) (int64, error)
+	ListLedgerEntries(ctx context.Context, userID string) ([]*pb.LedgerEntry, error)
+	RecordPayment(ctx context.Context, userID string, amountCents int64, note, recordedBy string) (*pb.LedgerEntry, int64, error)
+	WaiveFine(ctx context.Context, chargeID, note, recordedBy string) (*pb.LedgerEntry, int64, error)
 }
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	pb "library-management-service/proto/library/v1"
+)
+
+var (
+	ErrLedgerEntryNotFound = newError(ErrNotFound, "LEDGER_ENTRY_NOT_FOUND", "ledger entry not found")
+	ErrNotAFine            = newError(ErrInvalid, "NOT_A_FINE", "ledger entry is not a fine")
+	ErrFineSettled         = newError(ErrConflict, "FINE_SETTLED", "fine has already been paid or waived")
+	ErrOverpayment         = newError(ErrConflict, "OVERPAYMENT", "payment exceeds the balance owed")
+)
+
+// balanceOwed is the balance of the user selected by the enclosing query: the sum of
+// charges less payments and waivers
+const balanceOwed = `COALESCE((
+		SELECT SUM(CASE ledger_entries.kind WHEN 'charge' THEN ledger_entries.amount_cents ELSE -ledger_entries.amount_cents END)
+		FROM ledger_entries WHERE ledger_entries.user_id = users.id
+	), 0)::bigint`
+
+// ledgerEntryColumns lists the columns read by scanLedgerEntry, in order
+const ledgerEntryColumns = `id, user_id, kind, amount_cents, COALESCE(borrow_id::text, ''),
+	COALESCE(waived_entry_id::text, ''), note, COALESCE(recorded_by::text, ''), created_at`
+
+// GetBalance returns the amount the user owes
+func (r *UserRepository) GetBalance(ctx context.Context, userID string) (int64, error) {
+	var balance int64
+	err := r.db.Pool.QueryRow(ctx, "SELECT "+balanceOwed+" FROM users WHERE id = $1", userID).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrUserNotFound
+		}
+		return 0, fmt.Errorf("database error: %w", err)
+	}
+
+	return balance, nil
+}
+
+// ListLedgerEntries returns the charges, payments and waivers on the user's account, oldest first
+func (r *UserRepository) ListLedgerEntries(ctx context.Context, userID string) ([]*pb.LedgerEntry, error) {
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+ledgerEntryColumns+`
+		FROM ledger_entries
+		WHERE user_id = $1
+		ORDER BY created_at, id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*pb.LedgerEntry
+	for rows.Next() {
+		entry, err := scanLedgerEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ledger entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// RecordPayment credits a payment of up to the balance owed to the user's account.
+// It returns the payment and the remaining balance.
+func (r *UserRepository) RecordPayment(ctx context.Context, userID string, amountCents int64, note, recordedBy string) (*pb.LedgerEntry, int64, error) {
+	var entry *pb.LedgerEntry
+	var balance int64
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		balance, err = lockBalance(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if amountCents > balance {
+			return ErrOverpayment
+		}
+
+		entry, err = scanLedgerEntry(tx.QueryRow(ctx, `
+			INSERT INTO ledger_entries (user_id, kind, amount_cents, note, recorded_by)
+			VALUES ($1, 'payment', $2, $3, $4)
+			RETURNING `+ledgerEntryColumns,
+			userID, amountCents, note, recordedBy))
+		if err != nil {
+			return fmt.Errorf("failed to record payment: %w", err)
+		}
+		balance -= amountCents
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entry, balance, nil
+}
+
+// WaiveFine cancels what is still owed on a fine. Only the part of the fine that has not been
+// paid off is waived, so a waiver never leaves the account in credit. It returns the waiver and
+// the remaining balance.
+func (r *UserRepository) WaiveFine(ctx context.Context, chargeID, note, recordedBy string) (*pb.LedgerEntry, int64, error) {
+	var entry *pb.LedgerEntry
+	var balance int64
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var userID, kind string
+		var chargeCents int64
+		err := tx.QueryRow(ctx, `
+			SELECT user_id, kind, amount_cents FROM ledger_entries WHERE id = $1
+		`, chargeID).Scan(&userID, &kind, &chargeCents)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrLedgerEntryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get ledger entry: %w", err)
+		}
+		if kind != "charge" {
+			return ErrNotAFine
+		}
+
+		balance, err = lockBalance(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		var waived bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM ledger_entries WHERE waived_entry_id = $1)
+		`, chargeID).Scan(&waived)
+		if err != nil {
+			return fmt.Errorf("failed to check waivers: %w", err)
+		}
+		waiveCents := min(chargeCents, balance)
+		if waived || waiveCents <= 0 {
+			return ErrFineSettled
+		}
+
+		entry, err = scanLedgerEntry(tx.QueryRow(ctx, `
+			INSERT INTO ledger_entries (user_id, kind, amount_cents, waived_entry_id, note, recorded_by)
+			VALUES ($1, 'waiver', $2, $3, $4, $5)
+			RETURNING `+ledgerEntryColumns,
+			userID, waiveCents, chargeID, note, recordedBy))
+		if err != nil {
+			return fmt.Errorf("failed to waive fine: %w", err)
+		}
+		balance -= waiveCents
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entry, balance, nil
+}
+
+// lockBalance locks the user's account against concurrent payments and waivers and returns
+// the balance owed
+func lockBalance(ctx context.Context, tx pgx.Tx, userID string) (int64, error) {
+	var balance int64
+	err := tx.QueryRow(ctx, "SELECT "+balanceOwed+" FROM users WHERE id = $1 FOR UPDATE", userID).Scan(&balance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrUserNotFound
+		}
+		return 0, fmt.Errorf("failed to get balance: %w", err)
+	}
+	return balance, nil
+}
+
+// chargeFine adds the overdue fine for a returned borrow to the borrower's account
+func chargeFine(ctx context.Context, tx pgx.Tx, borrowID string, fineCents int64, daysOverdue int) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO ledger_entries (user_id, kind, amount_cents, borrow_id, note)
+		SELECT user_id, 'charge', $2, id, $3 FROM borrows WHERE id = $1
+	`, borrowID, fineCents, fmt.Sprintf("Returned %d days overdue", daysOverdue))
+	if err != nil {
+		return fmt.Errorf("failed to charge fine: %w", err)
+	}
+	return nil
+}
+
+func scanLedgerEntry(row pgx.Row) (*pb.LedgerEntry, error) {
+	var entry pb.LedgerEntry
+	var kind string
+	var createdAt time.Time
+	err := row.Scan(&entry.Id, &entry.UserId, &kind, &entry.AmountCents, &entry.BorrowId,
+		&entry.WaivedEntryId, &entry.Note, &entry.RecordedBy, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	entry.Kind = LedgerEntryKindFromName(kind)
+	entry.CreatedAt = createdAt.Format(time.RFC3339)
+	return &entry, nil
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/database"
+	pb "library-management-service/proto/library/v1"
+)
+
+// expectBalance expects a user's account to be locked with balanceCents owed
+func expectBalance(ctx context.Context, mockTx *MockTx, balanceCents int64) {
+	mockBalanceRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBalanceRow).Once()
+	mockBalanceRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*int64)) = balanceCents
+	}).Return(nil)
+}
+
+// expectLedgerInsert expects a ledger entry of the given kind to be inserted
+func expectLedgerInsert(ctx context.Context, mockTx *MockTx, kind string, amountCents int64) {
+	mockEntryRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockEntryRow).Once()
+	mockEntryRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "entry-id-1"
+		*(dests[1].(*string)) = "user-id-123"
+		*(dests[2].(*string)) = kind
+		*(dests[3].(*int64)) = amountCents
+		*(dests[8].(*time.Time)) = time.Now()
+	}).Return(nil)
+}
+
+// TestUserRepository_RecordPayment tests that a payment is credited against the balance
+func TestUserRepository_RecordPayment(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBalance(ctx, mockTx, 300)
+	expectLedgerInsert(ctx, mockTx, "payment", 200)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	entry, balance, err := repo.RecordPayment(ctx, "user-id-123", 200, "cash", "librarian-id")
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, pb.LedgerEntryKind_LEDGER_ENTRY_KIND_PAYMENT, entry.Kind)
+	assert.Equal(t, int64(100), balance)
+
+	insertArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", int64(200), "cash", "librarian-id"}, insertArgsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_RecordPayment_Overpayment tests that payments cannot exceed the balance
+func TestUserRepository_RecordPayment_Overpayment(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBalance(ctx, mockTx, 300)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	entry, _, err := repo.RecordPayment(ctx, "user-id-123", 301, "", "librarian-id")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrOverpayment)
+	assert.Nil(t, entry)
+
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestUserRepository_WaiveFine tests that only the unpaid part of a fine is waived
+func TestUserRepository_WaiveFine(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockChargeRow := new(MockRow)
+	mockWaivedRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. A fine of 500, of which 200 has been paid
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockChargeRow).Once()
+	mockChargeRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "user-id-123"
+		*(dests[1].(*string)) = "charge"
+		*(dests[2].(*int64)) = 500
+	}).Return(nil)
+	expectBalance(ctx, mockTx, 300)
+
+	// 2. It has not been waived before
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockWaivedRow).Once()
+	mockWaivedRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = false
+	}).Return(nil)
+
+	// 3. The remaining 300 is waived
+	expectLedgerInsert(ctx, mockTx, "waiver", 300)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	entry, balance, err := repo.WaiveFine(ctx, "charge-id-1", "first offence", "librarian-id")
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, pb.LedgerEntryKind_LEDGER_ENTRY_KIND_WAIVER, entry.Kind)
+	assert.Equal(t, int64(0), balance)
+
+	insertArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", int64(300), "charge-id-1", "first offence", "librarian-id"}, insertArgsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_WaiveFine_NotAFine tests that payments and waivers cannot be waived
+func TestUserRepository_WaiveFine_NotAFine(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockEntryRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockEntryRow).Once()
+	mockEntryRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "user-id-123"
+		*(dests[1].(*string)) = "payment"
+		*(dests[2].(*int64)) = 500
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	entry, _, err := repo.WaiveFine(ctx, "payment-id-1", "", "librarian-id")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrNotAFine)
+	assert.Nil(t, entry)
+
+	mockTx.AssertExpectations(t)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"library-management-service/internal/pagination"
+	pb "library-management-service/proto/library/v1"
+)
+
+// LoanFilter selects the loans returned by ListLoans. Empty fields match every loan.
+type LoanFilter struct {
+	UserID string
+	BookID string
+	State  pb.LoanState
+}
+
+// loanColumns are the columns scanLoan reads, in order
+const loanColumns = `borrows.id, borrows.book_id, books.title,
+	COALESCE(borrows.copy_id::text, ''), COALESCE(book_copies.barcode, ''), COALESCE(borrows.user_id::text, ''),
+	borrows.borrow_date, borrows.due_date, borrows.return_date, borrows.renewal_count,
+	borrows.return_date IS NULL AND borrows.due_date < NOW()`
+
+// loanOrder sorts loans most recently borrowed first. Borrow times are compared to the second,
+// the precision of the cursor keys taken from pb.Loan.
+const loanOrder = `date_trunc('second', borrows.borrow_date)`
+
+// LoanKey returns the cursor key of a loan, to be passed back to ListLoans
+func LoanKey(loan *pb.Loan) string {
+	return loan.BorrowedAt
+}
+
+// ListLoans returns up to limit loans matching the filter, most recently borrowed first,
+// starting after the cursor when one is given. Loans borrowed in the same second are ordered by
+// id. Loans of deleted titles are included.
+func (r *BookRepository) ListLoans(ctx context.Context, filter LoanFilter, limit int32, after *pagination.Cursor) ([]*pb.Loan, error) {
+	args := []interface{}{limit}
+	param := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	var conditions []string
+	if filter.UserID != "" {
+		conditions = append(conditions, "borrows.user_id = "+param(filter.UserID))
+	}
+	if filter.BookID != "" {
+		conditions = append(conditions, "borrows.book_id = "+param(filter.BookID))
+	}
+	switch filter.State {
+	case pb.LoanState_LOAN_STATE_ACTIVE:
+		conditions = append(conditions, "borrows.return_date IS NULL")
+	case pb.LoanState_LOAN_STATE_RETURNED:
+		conditions = append(conditions, "borrows.return_date IS NOT NULL")
+	case pb.LoanState_LOAN_STATE_OVERDUE:
+		conditions = append(conditions, "borrows.return_date IS NULL AND borrows.due_date < NOW()")
+	}
+	if after != nil {
+		borrowedAt, err := time.Parse(time.RFC3339, after.Key)
+		if err != nil {
+			return nil, pagination.ErrInvalidToken
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s, borrows.id) < (%s, %s)", loanOrder, param(borrowedAt), param(after.ID)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+loanColumns+`
+		FROM borrows
+		JOIN books ON books.id = borrows.book_id
+		LEFT JOIN book_copies ON book_copies.id = borrows.copy_id
+		`+where+`
+		ORDER BY `+loanOrder+` DESC, borrows.id DESC
+		LIMIT $1
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loans: %w", err)
+	}
+	defer rows.Close()
+
+	var loans []*pb.Loan
+	for rows.Next() {
+		loan, err := scanLoan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan loan: %w", err)
+		}
+		loans = append(loans, loan)
+	}
+
+	return loans, rows.Err()
+}
+
+func scanLoan(row pgx.Row) (*pb.Loan, error) {
+	var loan pb.Loan
+	var borrowedAt, dueDate time.Time
+	var returnedAt *time.Time
+	err := row.Scan(&loan.Id, &loan.BookId, &loan.BookTitle, &loan.CopyId, &loan.Barcode, &loan.UserId,
+		&borrowedAt, &dueDate, &returnedAt, &loan.RenewalCount, &loan.Overdue)
+	if err != nil {
+		return nil, err
+	}
+	loan.BorrowedAt = borrowedAt.Format(time.RFC3339)
+	loan.DueDate = dueDate.Format(time.RFC3339)
+	if returnedAt != nil {
+		loan.ReturnedAt = returnedAt.Format(time.RFC3339)
+	}
+	return &loan, nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/database"
+	"library-management-service/internal/pagination"
+	pb "library-management-service/proto/library/v1"
+)
+
+// TestBookRepository_ListLoans tests that the filter and cursor are applied, newest loans first
+func TestBookRepository_ListLoans(t *testing.T) {
+	borrowedAt := time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		filter    LoanFilter
+		condition string
+	}{
+		"Active":   {LoanFilter{UserID: "user-id-1", State: pb.LoanState_LOAN_STATE_ACTIVE}, "WHERE borrows.user_id = $2 AND borrows.return_date IS NULL AND"},
+		"Returned": {LoanFilter{UserID: "user-id-1", State: pb.LoanState_LOAN_STATE_RETURNED}, "WHERE borrows.user_id = $2 AND borrows.return_date IS NOT NULL AND"},
+		"Overdue":  {LoanFilter{UserID: "user-id-1", State: pb.LoanState_LOAN_STATE_OVERDUE}, "WHERE borrows.user_id = $2 AND borrows.return_date IS NULL AND borrows.due_date < NOW() AND"},
+		"Any":      {LoanFilter{UserID: "user-id-1"}, "WHERE borrows.user_id = $2 AND ("},
+		"Title":    {LoanFilter{BookID: "book-id-1", UserID: "user-id-1"}, "WHERE borrows.user_id = $2 AND borrows.book_id = $3 AND"},
+	}
+
+	for name, tc := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockRows := &MockRows{}
+
+		repo := NewBookRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+		mockRows.On("Close").Return()
+
+		// Execute
+		loans, err := repo.ListLoans(ctx, tc.filter, 11, &pagination.Cursor{Key: borrowedAt.Format(time.RFC3339), ID: "borrow-id-1"})
+
+		// Verify
+		assert.NoError(t, err, name)
+		assert.Empty(t, loans, name)
+
+		query := mockPool.Calls[0].Arguments[1].(string)
+		assert.Contains(t, query, tc.condition, name)
+		assert.Contains(t, query, "(date_trunc('second', borrows.borrow_date), borrows.id) < (", name)
+		assert.Contains(t, query, "ORDER BY date_trunc('second', borrows.borrow_date) DESC, borrows.id DESC", name)
+		argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+		assert.Equal(t, int32(11), argsSlice[0], name)
+		assert.Equal(t, []interface{}{borrowedAt, "borrow-id-1"}, argsSlice[len(argsSlice)-2:], name)
+		mockRows.AssertExpectations(t)
+	}
+}
+
+// TestBookRepository_ListLoans_InvalidCursor tests that a cursor key that is not a time is refused
+func TestBookRepository_ListLoans_InvalidCursor(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Execute
+	loans, err := repo.ListLoans(ctx, LoanFilter{}, 11, &pagination.Cursor{Key: "The Hobbit", ID: "borrow-id-1"})
+
+	// Verify
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+	assert.Nil(t, loans)
+	mockPool.AssertNotCalled(t, "Query", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestScanLoan tests that open and returned loans are converted to pb.Loan
+func TestScanLoan(t *testing.T) {
+	borrowedAt := time.Date(2024, 3, 1, 10, 30, 15, 500, time.UTC)
+	dueDate := borrowedAt.Add(14 * 24 * time.Hour)
+	returnedAt := borrowedAt.Add(24 * time.Hour)
+
+	for name, returned := range map[string]*time.Time{"Open": nil, "Returned": &returnedAt} {
+		mockRow := new(MockRow)
+		mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			dests := args.Get(0).([]interface{})
+			*(dests[0].(*string)) = "borrow-id-1"
+			*(dests[2].(*string)) = "The Hobbit"
+			*(dests[6].(*time.Time)) = borrowedAt
+			*(dests[7].(*time.Time)) = dueDate
+			*(dests[8].(**time.Time)) = returned
+			*(dests[9].(*int32)) = 1
+		}).Return(nil)
+
+		loan, err := scanLoan(mockRow)
+
+		assert.NoError(t, err, name)
+		assert.Equal(t, "borrow-id-1", loan.Id, name)
+		assert.Equal(t, "The Hobbit", loan.BookTitle, name)
+		assert.Equal(t, "2024-03-01T10:30:15Z", loan.BorrowedAt, name)
+		assert.Equal(t, "2024-03-15T10:30:15Z", loan.DueDate, name)
+		assert.Equal(t, int32(1), loan.RenewalCount, name)
+		if returned == nil {
+			assert.Empty(t, loan.ReturnedAt, name)
+		} else {
+			assert.Equal(t, "2024-03-02T10:30:15Z", loan.ReturnedAt, name)
+		}
+	}
+}
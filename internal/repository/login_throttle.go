@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// LoginLockout configures how failed logins under one throttle key lock out further attempts
+type LoginLockout struct {
+	// Threshold is the number of failures allowed before the first lockout
+	Threshold int
+	// BaseDelay is the first lockout; every further failure doubles it, up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Window is how long failures are remembered after the most recent one
+	Window time.Duration
+}
+
+// Delay returns how long to lock out logins after the given number of consecutive failures
+func (l LoginLockout) Delay(failures int) time.Duration {
+	if l.Threshold <= 0 || failures < l.Threshold {
+		return 0
+	}
+	delay := l.BaseDelay
+	for i := l.Threshold; i < failures && delay < l.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, l.MaxDelay)
+}
+
+// LoginLockedUntil returns when the latest lockout among the throttle keys ends, or the zero
+// time when none of them is locked out
+func (r *UserRepository) LoginLockedUntil(ctx context.Context, keys ...string) (time.Time, error) {
+	var lockedUntil *time.Time
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT MAX(locked_until) FROM login_throttles
+		WHERE key = ANY($1) AND locked_until > NOW()
+	`, keys).Scan(&lockedUntil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to check login lockout: %w", err)
+	}
+	if lockedUntil == nil {
+		return time.Time{}, nil
+	}
+
+	return *lockedUntil, nil
+}
+
+// RecordLoginFailure counts a failed login under a throttle key and returns when the resulting
+// lockout ends, or the zero time when the failure does not lock the key out
+func (r *UserRepository) RecordLoginFailure(ctx context.Context, key string, lockout LoginLockout) (time.Time, error) {
+	var lockedUntil time.Time
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO login_throttles (key) VALUES ($1)
+			ON CONFLICT (key) DO NOTHING
+		`, key)
+		if err != nil {
+			return fmt.Errorf("failed to create login throttle: %w", err)
+		}
+
+		// Lock the key so that concurrent failures are all counted
+		var failures int
+		var lastFailureAt *time.Time
+		err = tx.QueryRow(ctx, `
+			SELECT failures, last_failure_at FROM login_throttles
+			WHERE key = $1
+			FOR UPDATE
+		`, key).Scan(&failures, &lastFailureAt)
+		if err != nil {
+			return fmt.Errorf("failed to get login throttle: %w", err)
+		}
+
+		now := time.Now()
+		if lastFailureAt == nil || now.Sub(*lastFailureAt) > lockout.Window {
+			failures = 0
+		}
+		failures++
+
+		var lockedUntilArg *time.Time
+		if delay := lockout.Delay(failures); delay > 0 {
+			lockedUntil = now.Add(delay)
+			lockedUntilArg = &lockedUntil
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE login_throttles SET failures = $2, last_failure_at = $3, locked_until = $4
+			WHERE key = $1
+		`, key, failures, now, lockedUntilArg)
+		if err != nil {
+			return fmt.Errorf("failed to record login failure: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return lockedUntil, nil
+}
+
+// ClearLoginFailures forgets the failures and lifts the lockouts of the throttle keys
+func (r *UserRepository) ClearLoginFailures(ctx context.Context, keys ...string) error {
+	_, err := r.db.Pool.Exec(ctx, `DELETE FROM login_throttles WHERE key = ANY($1)`, keys)
+	if err != nil {
+		return fmt.Errorf("failed to clear login failures: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"library-management-service/internal/database"
+)
+
+var testLockout = LoginLockout{Threshold: 3, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute, Window: time.Hour}
+
+// TestLoginLockout_Delay tests that lockouts start at the threshold and double up to the maximum
+func TestLoginLockout_Delay(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:   0,
+		2:   0,
+		3:   time.Minute,
+		4:   2 * time.Minute,
+		5:   4 * time.Minute,
+		6:   8 * time.Minute,
+		7:   10 * time.Minute,
+		100: 10 * time.Minute,
+	}
+
+	for failures, delay := range cases {
+		assert.Equal(t, delay, testLockout.Delay(failures), "%d failures", failures)
+	}
+	assert.Zero(t, LoginLockout{}.Delay(100))
+}
+
+// expectLoginThrottle expects a failure to be recorded against a key with the given history
+func expectLoginThrottle(ctx context.Context, mockTx *MockTx, failures int, lastFailureAt *time.Time) {
+	mockRow := new(MockRow)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("INSERT 0 0"), nil).Once()
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow).Once()
+	mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*int)) = failures
+		*(dests[1].(**time.Time)) = lastFailureAt
+	}).Return(nil)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+}
+
+// TestUserRepository_RecordLoginFailure tests counting failures and locking out at the threshold
+func TestUserRepository_RecordLoginFailure(t *testing.T) {
+	recent := time.Now().Add(-time.Minute)
+	stale := time.Now().Add(-2 * time.Hour)
+
+	cases := map[string]struct {
+		failures      int
+		lastFailureAt *time.Time
+		wantFailures  int
+		wantDelay     time.Duration
+	}{
+		"First Failure":     {0, nil, 1, 0},
+		"Below Threshold":   {1, &recent, 2, 0},
+		"At Threshold":      {2, &recent, 3, time.Minute},
+		"Past Threshold":    {4, &recent, 5, 4 * time.Minute},
+		"Forgotten Failure": {4, &stale, 1, 0},
+	}
+
+	for name, tc := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockTx := new(MockTx)
+
+		repo := NewUserRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+		expectLoginThrottle(ctx, mockTx, tc.failures, tc.lastFailureAt)
+
+		// Execute
+		lockedUntil, err := repo.RecordLoginFailure(ctx, "account:ada@example.com", testLockout)
+
+		// Verify
+		assert.NoError(t, err, name)
+		assert.Contains(t, mockTx.Calls[1].Arguments[1].(string), "FOR UPDATE", name)
+
+		updateArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+		assert.Equal(t, "account:ada@example.com", updateArgsSlice[0], name)
+		assert.Equal(t, tc.wantFailures, updateArgsSlice[1], name)
+		if tc.wantDelay == 0 {
+			assert.True(t, lockedUntil.IsZero(), name)
+			assert.Nil(t, updateArgsSlice[3], name)
+		} else {
+			assert.WithinDuration(t, time.Now().Add(tc.wantDelay), lockedUntil, time.Second, name)
+			assert.Equal(t, lockedUntil, *updateArgsSlice[3].(*time.Time), name)
+		}
+		mockTx.AssertExpectations(t)
+	}
+}
+
+// TestUserRepository_LoginLockedUntil tests reading the latest lockout among the keys
+func TestUserRepository_LoginLockedUntil(t *testing.T) {
+	lockedUntil := time.Now().Add(time.Minute)
+
+	cases := map[string]*time.Time{
+		"Locked":     &lockedUntil,
+		"Not Locked": nil,
+	}
+
+	for name, stored := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockRow := new(MockRow)
+
+		repo := NewUserRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+		mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*(args.Get(0).([]interface{})[0].(**time.Time)) = stored
+		}).Return(nil)
+
+		// Execute
+		until, err := repo.LoginLockedUntil(ctx, "account:ada@example.com", "ip:192.0.2.1")
+
+		// Verify
+		assert.NoError(t, err, name)
+		if stored == nil {
+			assert.True(t, until.IsZero(), name)
+		} else {
+			assert.Equal(t, lockedUntil, until, name)
+		}
+		argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+		assert.Equal(t, []interface{}{[]string{"account:ada@example.com", "ip:192.0.2.1"}}, argsSlice, name)
+	}
+}
+
+// TestUnknownUserPasswordHash tests that unknown emails cost as much to check as stored passwords
+func TestUnknownUserPasswordHash(t *testing.T) {
+	cost, err := bcrypt.Cost([]byte(unknownUserPasswordHash))
+	assert.NoError(t, err)
+	assert.Equal(t, bcrypt.DefaultCost, cost)
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"library-management-service/internal/database"
+	"library-management-service/internal/policy"
+)
+
+// PolicyRepository reads the circulation policy overrides kept in the database
+type PolicyRepository struct {
+	db *database.DB
+}
+
+func NewPolicyRepository(db *database.DB) *PolicyRepository {
+	return &PolicyRepository{db: db}
+}
+
+func (r *PolicyRepository) ListPolicies(ctx context.Context) ([]policy.Rule, error) {
+	return listPolicies(ctx, r.db.Pool)
+}
+
+// txPolicies reads the policy overrides through an open transaction. Rules resolved while the
+// transaction holds row locks must not wait for a second connection from the pool, which may
+// never come free when every connection is held by such a transaction.
+type txPolicies struct {
+	tx pgx.Tx
+}
+
+func (s txPolicies) ListPolicies(ctx context.Context) ([]policy.Rule, error) {
+	return listPolicies(ctx, s.tx)
+}
+
+func listPolicies(ctx context.Context, q database.Querier) ([]policy.Rule, error) {
+	rows, err := q.Query(ctx, `
+		SELECT patron_type, item_type, loan_days, max_loans, max_renewals,
+			renewal_grace_days, fine_per_day_cents, max_fine_cents, fine_grace_days, max_balance_cents
+		FROM circulation_policies
+		ORDER BY patron_type, item_type
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list circulation policies: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []policy.Rule
+	for rows.Next() {
+		var rule policy.Rule
+		err := rows.Scan(&rule.PatronType, &rule.ItemType, &rule.LoanDays, &rule.MaxLoans, &rule.MaxRenewals,
+			&rule.RenewalGraceDays, &rule.FinePerDayCents, &rule.MaxFineCents, &rule.FineGraceDays, &rule.MaxBalanceCents)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan circulation policy: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
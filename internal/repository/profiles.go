@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	"golang.org/x/crypto/bcrypt"
+	"library-management-service/internal/pagination"
+	pb "library-management-service/proto/library/v1"
+)
+
+// UpdateProfile changes the name and email of an active user, leaving empty ones unchanged.
+// A changed email is no longer verified.
+func (r *UserRepository) UpdateProfile(ctx context.Context, userID, name, email string) (*pb.User, error) {
+	user, err := scanUser(r.db.Pool.QueryRow(ctx, `
+		UPDATE users SET
+			name = COALESCE(NULLIF($2, ''), name),
+			email = COALESCE(NULLIF($3, ''), email),
+			email_verified = email_verified AND (NULLIF($3, '') IS NULL OR $3 = email),
+			updated_at = NOW()
+		WHERE id = $1 AND deactivated_at IS NULL
+		RETURNING `+userColumns+`
+	`, userID, name, email))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateEmail
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return user, nil
+}
+
+// ChangePassword replaces the password of an active user, provided that currentPassword is
+// the password being replaced, and ends all of the user's sessions
+func (r *UserRepository) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Lock the user so that concurrent changes cannot both check the same password
+		var passwordHash string
+		err := tx.QueryRow(ctx, `
+			SELECT password_hash FROM users
+			WHERE id = $1 AND deactivated_at IS NULL
+			FOR UPDATE
+		`, userID).Scan(&passwordHash)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		if bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(currentPassword)) != nil {
+			return ErrWrongPassword
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE users SET password_hash = $2, updated_at = NOW()
+			WHERE id = $1
+		`, userID, string(hashedPassword))
+		if err != nil {
+			return fmt.Errorf("failed to change password: %w", err)
+		}
+		return revokeUserSessions(ctx, tx, userID)
+	})
+}
+
+// Deactivate stops a user from logging in and borrowing. The user's loans and ledger stay on
+// record; deactivating a deactivated user changes nothing.
+func (r *UserRepository) Deactivate(ctx context.Context, userID string) (*pb.User, error) {
+	user, err := scanUser(r.db.Pool.QueryRow(ctx, `
+		UPDATE users SET
+			deactivated_at = COALESCE(deactivated_at, NOW()),
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING `+userColumns+`
+	`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to deactivate user: %w", err)
+	}
+
+	return user, nil
+}
+
+// List returns up to limit users ordered by name, starting after the cursor when one is given.
+// A non-empty query matches any part of the name or email, ignoring case.
+func (r *UserRepository) List(ctx context.Context, query string, includeDeactivated bool, limit int32, after *pagination.Cursor) ([]*pb.User, error) {
+	args := []interface{}{limit}
+	param := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	var conditions []string
+	if !includeDeactivated {
+		conditions = append(conditions, "users.deactivated_at IS NULL")
+	}
+	if query != "" {
+		pattern := param(escapeLike(query))
+		conditions = append(conditions, fmt.Sprintf("(users.name ILIKE '%%' || %s || '%%' OR users.email ILIKE '%%' || %s || '%%')", pattern, pattern))
+	}
+	if after != nil {
+		conditions = append(conditions, fmt.Sprintf("(users.name, users.id) > (%s, %s)", param(after.Key), param(after.ID)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+userColumns+`
+		FROM users
+		`+where+`
+		ORDER BY users.name, users.id
+		LIMIT $1
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*pb.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
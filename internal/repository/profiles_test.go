@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"library-management-service/internal/database"
+	"library-management-service/internal/pagination"
+)
+
+// expectPasswordHash expects an active user to be locked with the hash of password
+func expectPasswordHash(ctx context.Context, mockTx *MockTx, password string) {
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	mockHashRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHashRow).Once()
+	mockHashRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = string(hashedPassword)
+	}).Return(nil)
+}
+
+// TestUserRepository_UpdateProfile tests that a changed email is no longer verified
+func TestUserRepository_UpdateProfile(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+	mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "user-id-123"
+		*(dests[2].(*string)) = "new@example.com"
+		*(dests[6].(*bool)) = true
+	}).Return(nil)
+
+	// Execute
+	user, err := repo.UpdateProfile(ctx, "user-id-123", "", "new@example.com")
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "new@example.com", user.Email)
+	assert.False(t, user.EmailVerified)
+	assert.True(t, user.Active)
+
+	query := mockPool.Calls[0].Arguments[1].(string)
+	assert.Contains(t, query, "email_verified = email_verified AND (NULLIF($3, '') IS NULL OR $3 = email)")
+	assert.Contains(t, query, "WHERE id = $1 AND deactivated_at IS NULL")
+	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "", "new@example.com"}, argsSlice)
+
+	mockPool.AssertExpectations(t)
+}
+
+// TestUserRepository_UpdateProfile_EmailTaken tests that another user's email is refused
+func TestUserRepository_UpdateProfile_EmailTaken(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+	mockRow.On("Scan", mock.Anything).Return(&pgconn.PgError{Code: "23505", ConstraintName: "users_email_key"})
+
+	// Execute
+	user, err := repo.UpdateProfile(ctx, "user-id-123", "", "taken@example.com")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrDuplicateEmail)
+	assert.Nil(t, user)
+}
+
+// TestUserRepository_ChangePassword tests that the new password replaces the current one
+func TestUserRepository_ChangePassword(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectPasswordHash(ctx, mockTx, "password123")
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Twice()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	err := repo.ChangePassword(ctx, "user-id-123", "password123", "correct horse")
+
+	// Verify
+	assert.NoError(t, err)
+
+	argsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, "user-id-123", argsSlice[0])
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(argsSlice[1].(string)), []byte("correct horse")))
+
+	// Sessions started with the old password end
+	assert.Contains(t, mockTx.Calls[2].Arguments[1].(string), "UPDATE sessions SET revoked_at = NOW()")
+	assert.Equal(t, []interface{}{"user-id-123"}, mockTx.Calls[2].Arguments[2].([]interface{}))
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_ChangePassword_WrongPassword tests that the current password must match
+func TestUserRepository_ChangePassword_WrongPassword(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectPasswordHash(ctx, mockTx, "password123")
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	err := repo.ChangePassword(ctx, "user-id-123", "password124", "correct horse")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrWrongPassword)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestUserRepository_List tests that the search and cursor are applied to active users
+func TestUserRepository_List(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRows := &MockRows{}
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+	mockRows.On("Close").Return()
+
+	// Execute
+	users, err := repo.List(ctx, "100%", false, 11, &pagination.Cursor{Key: "Ada", ID: "user-id-1"})
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+
+	query := mockPool.Calls[0].Arguments[1].(string)
+	assert.Contains(t, query, "WHERE users.deactivated_at IS NULL AND (users.name ILIKE '%' || $2 || '%' OR users.email ILIKE '%' || $2 || '%') AND (users.name, users.id) > ($3, $4)")
+	assert.Contains(t, query, "ORDER BY users.name, users.id")
+	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{int32(11), `100\%`, "Ada", "user-id-1"}, argsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockRows.AssertExpectations(t)
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"library-management-service/internal/policy"
+)
+
+// ErrLoanReturned is returned when a closed borrow is returned or renewed
+var ErrLoanReturned = newError(ErrConflict, "LOAN_RETURNED", "book has already been returned")
+
+// Renewal is the state of a loan after it has been renewed
+type Renewal struct {
+	BorrowID          string
+	DueDate           time.Time
+	RenewalCount      int
+	RenewalsRemaining int
+}
+
+// RenewLoan extends the due date of an open borrow by the loan period of the rule for the
+// borrower's patron type and the copy's item type, counted from the old due date or from now
+// when the loan is already overdue. Each renewal is recorded together with the user who made it.
+func (r *BookRepository) RenewLoan(ctx context.Context, borrowID, renewedBy string, rules policy.Resolver) (*Renewal, error) {
+	renewal := &Renewal{BorrowID: borrowID}
+
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Lock the borrow so that concurrent renewals cannot exceed the limit
+		var bookID, patronType, itemType string
+		var dueDate time.Time
+		var returned bool
+		err := tx.QueryRow(ctx, `
+			SELECT borrows.book_id, borrows.due_date, borrows.renewal_count, borrows.return_date IS NOT NULL,
+				users.patron_type, book_copies.item_type
+			FROM borrows
+			JOIN users ON users.id = borrows.user_id
+			JOIN book_copies ON book_copies.id = borrows.copy_id
+			WHERE borrows.id = $1
+			FOR UPDATE OF borrows
+		`, borrowID).Scan(&bookID, &dueDate, &renewal.RenewalCount, &returned, &patronType, &itemType)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrBorrowNotFound
+			}
+			return fmt.Errorf("failed to get borrow: %w", err)
+		}
+		if returned {
+			return ErrLoanReturned
+		}
+
+		rule, err := rules.Using(txPolicies{tx}).Resolve(ctx, patronType, itemType)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if renewal.RenewalCount >= rule.MaxRenewals {
+			return policy.Violate(policy.ReasonMaxRenewals, fmt.Sprintf("renewal limit of %d reached", rule.MaxRenewals))
+		}
+		if now.After(dueDate.Add(rule.RenewalGracePeriod())) {
+			return policy.Violate(policy.ReasonOverdueBeyondGrace, "loan is overdue beyond the renewal grace period")
+		}
+
+		// Patrons waiting for the title get the copy back on time
+		var waiting bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM holds WHERE book_id = $1 AND status = 'waiting')
+		`, bookID).Scan(&waiting)
+		if err != nil {
+			return fmt.Errorf("failed to check holds: %w", err)
+		}
+		if waiting {
+			return policy.Violate(policy.ReasonHoldsWaiting, "other patrons are waiting for this book")
+		}
+
+		renewFrom := dueDate
+		if now.After(dueDate) {
+			renewFrom = now
+		}
+		renewal.DueDate = renewFrom.Add(rule.LoanPeriod())
+		renewal.RenewalCount++
+		renewal.RenewalsRemaining = rule.MaxRenewals - renewal.RenewalCount
+
+		_, err = tx.Exec(ctx, `
+			UPDATE borrows SET due_date = $2, renewal_count = $3, updated_at = NOW()
+			WHERE id = $1
+		`, borrowID, renewal.DueDate, renewal.RenewalCount)
+		if err != nil {
+			return fmt.Errorf("failed to update borrow record: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO borrow_renewals (borrow_id, renewed_by, previous_due_date, new_due_date)
+			VALUES ($1, $2, $3, $4)
+		`, borrowID, renewedBy, dueDate, renewal.DueDate)
+		if err != nil {
+			return fmt.Errorf("failed to record renewal: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return renewal, nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/database"
+	"library-management-service/internal/policy"
+)
+
+// expectBorrowForRenewal expects RenewLoan to lock a borrow in the given state
+func expectBorrowForRenewal(ctx context.Context, mockTx *MockTx, dueDate time.Time, renewalCount int, returned bool) {
+	mockBorrowRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockBorrowRow).Once()
+	mockBorrowRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "book-id-123"
+		*(dests[1].(*time.Time)) = dueDate
+		*(dests[2].(*int)) = renewalCount
+		*(dests[3].(*bool)) = returned
+		*(dests[4].(*string)) = policy.DefaultType
+		*(dests[5].(*string)) = policy.DefaultType
+	}).Return(nil)
+}
+
+// TestBookRepository_RenewLoan tests that a renewal extends the due date and is recorded
+func TestBookRepository_RenewLoan(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockHoldsRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+	dueDate := time.Now().Add(48 * time.Hour)
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+
+	// 1. Lock the borrow, renewed once before
+	expectBorrowForRenewal(ctx, mockTx, dueDate, 1, false)
+
+	// 2. Nobody is waiting for the title
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldsRow).Once()
+	mockHoldsRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = false
+	}).Return(nil)
+
+	// 3. Extend the loan and record the renewal
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Once()
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("INSERT 0 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	renewal, err := repo.RenewLoan(ctx, "borrow-id-123", "user-id-123", testRules)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "borrow-id-123", renewal.BorrowID)
+	assert.Equal(t, dueDate.Add(policy.DefaultRules[0].LoanPeriod()), renewal.DueDate)
+	assert.Equal(t, 2, renewal.RenewalCount)
+	assert.Equal(t, 0, renewal.RenewalsRemaining)
+
+	holdsArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, "book-id-123", holdsArgsSlice[0])
+	updateArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"borrow-id-123", renewal.DueDate, 2}, updateArgsSlice)
+	historyArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"borrow-id-123", "user-id-123", dueDate, renewal.DueDate}, historyArgsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestBookRepository_RenewLoan_PolicyViolations tests the limits checked before a loan is renewed
+func TestBookRepository_RenewLoan_PolicyViolations(t *testing.T) {
+	cases := []struct {
+		name         string
+		dueDate      time.Time
+		renewalCount int
+		reason       string
+	}{
+		{"Limit Reached", time.Now().Add(time.Hour), 2, policy.ReasonMaxRenewals},
+		{"Beyond Grace Period", time.Now().Add(-4 * 24 * time.Hour), 0, policy.ReasonOverdueBeyondGrace},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup
+			mockPool := new(MockPgxPool)
+			mockTx := new(MockTx)
+
+			repo := NewBookRepository(&database.DB{Pool: mockPool})
+			ctx := context.Background()
+
+			// Expectations
+			mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+			expectBorrowForRenewal(ctx, mockTx, tc.dueDate, tc.renewalCount, false)
+			mockTx.On("Rollback", ctx).Return(nil).Once()
+
+			// Execute
+			renewal, err := repo.RenewLoan(ctx, "borrow-id-123", "user-id-123", testRules)
+
+			// Verify
+			assert.Nil(t, renewal)
+			violation, ok := policy.AsViolation(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, tc.reason, violation.Reason)
+			}
+
+			mockTx.AssertExpectations(t)
+			mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestBookRepository_RenewLoan_Returned tests that a returned loan cannot be renewed
+func TestBookRepository_RenewLoan_Returned(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrowForRenewal(ctx, mockTx, time.Now().Add(time.Hour), 0, true)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	renewal, err := repo.RenewLoan(ctx, "borrow-id-123", "user-id-123", testRules)
+
+	// Verify
+	assert.ErrorIs(t, err, ErrLoanReturned)
+	assert.Nil(t, renewal)
+
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestBookRepository_RenewLoan_HoldsWaiting tests that loans of titles with a waiting queue are not renewed
+func TestBookRepository_RenewLoan_HoldsWaiting(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockHoldsRow := new(MockRow)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations: an overdue loan within the grace period, but someone is waiting
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectBorrowForRenewal(ctx, mockTx, time.Now().Add(-24*time.Hour), 0, false)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockHoldsRow).Once()
+	mockHoldsRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*bool)) = true
+	}).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	renewal, err := repo.RenewLoan(ctx, "borrow-id-123", "user-id-123", testRules)
+
+	// Verify
+	assert.Nil(t, renewal)
+	violation, ok := policy.AsViolation(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, policy.ReasonHoldsWaiting, violation.Reason)
+	}
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+}
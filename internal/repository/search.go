@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"library-management-service/internal/pagination"
+	pb "library-management-service/proto/library/v1"
+)
+
+// BookSearch is a full-text query over the catalogue and the filters narrowing its matches.
+// Zero-valued filters are not applied.
+type BookSearch struct {
+	Query         string
+	AvailableOnly bool
+	Author        string
+	YearFrom      int32
+	YearTo        int32
+	Language      string
+}
+
+// searchRank scores a book against the query, favouring matches in the weightier fields.
+// ts_rank_cd returns a real, so ranks round-trip exactly through float32.
+const searchRank = "ts_rank_cd(books.search_vector, query)"
+
+// searchHeadline highlights the matched terms in the description, or in the title of books
+// without one
+const searchHeadline = `ts_headline('english',
+		CASE WHEN books.description = '' THEN books.title ELSE books.description END, matches.query,
+		'StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2')`
+
+// SearchKey returns the cursor key of a search result, to be passed back to Search
+func SearchKey(result *pb.BookSearchResult) string {
+	return strconv.FormatFloat(float64(result.Rank), 'g', -1, 32)
+}
+
+// Search returns up to limit books matching the search, most relevant first, starting after the
+// cursor when one is given. Books of equal rank are ordered by id.
+func (r *BookRepository) Search(ctx context.Context, search BookSearch, limit int32, after *pagination.Cursor) ([]*pb.BookSearchResult, error) {
+	args := []interface{}{search.Query, limit}
+	param := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"books.search_vector @@ query", "books.deleted_at IS NULL"}
+	if search.AvailableOnly {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM book_copies WHERE book_copies.book_id = books.id AND book_copies.status = 'available'
+		)`)
+	}
+	if search.Author != "" {
+		conditions = append(conditions, "books.author ILIKE '%' || "+param(escapeLike(search.Author))+" || '%'")
+	}
+	if search.YearFrom > 0 {
+		conditions = append(conditions, "books.publication_year >= "+param(search.YearFrom))
+	}
+	if search.YearTo > 0 {
+		conditions = append(conditions, "books.publication_year <= "+param(search.YearTo))
+	}
+	if search.Language != "" {
+		conditions = append(conditions, "lower(books.language) = lower("+param(search.Language)+")")
+	}
+	if after != nil {
+		rank, err := strconv.ParseFloat(after.Key, 32)
+		if err != nil {
+			return nil, pagination.ErrInvalidToken
+		}
+		rankParam, idParam := param(float32(rank)), param(after.ID)
+		conditions = append(conditions, fmt.Sprintf("(%s < %s OR (%s = %s AND books.id > %s))",
+			searchRank, rankParam, searchRank, rankParam, idParam))
+	}
+
+	// Rank and limit the matches first so that only the returned books are highlighted
+	rows, err := r.db.Pool.Query(ctx, `
+		SELECT `+bookColumns+`, matches.rank, `+searchHeadline+`
+		FROM (
+			SELECT books.id, query, `+searchRank+` AS rank
+			FROM books, websearch_to_tsquery('english', $1) query
+			WHERE `+strings.Join(conditions, " AND ")+`
+			ORDER BY rank DESC, books.id
+			LIMIT $2
+		) matches
+		JOIN books ON books.id = matches.id`+bookJoins+`
+		ORDER BY matches.rank DESC, books.id
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search books: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*pb.BookSearchResult
+	for rows.Next() {
+		var result pb.BookSearchResult
+		book, err := scanBook(rows, &result.Rank, &result.Snippet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		result.Book = book
+		results = append(results, &result)
+	}
+
+	return results, rows.Err()
+}
+
+// escapeLike escapes the LIKE wildcards in s so that it matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
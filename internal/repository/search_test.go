@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/database"
+	"library-management-service/internal/pagination"
+	pb "library-management-service/proto/library/v1"
+)
+
+// TestBookRepository_Search tests that the search query and each filter are passed as parameters
+func TestBookRepository_Search(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRows := &MockRows{data: [][5]string{{"book-id-1", "Dune", "Frank Herbert", "", "true"}}}
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+	mockRows.On("Close").Return()
+
+	// Execute
+	results, err := repo.Search(ctx, BookSearch{
+		Query:         "desert planet",
+		AvailableOnly: true,
+		Author:        "100%_herbert",
+		YearFrom:      1960,
+		YearTo:        1970,
+		Language:      "en",
+	}, 11, nil)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "Dune", results[0].Book.Title)
+
+	query := mockPool.Calls[0].Arguments[1].(string)
+	assert.Contains(t, query, "websearch_to_tsquery('english', $1)")
+	assert.Contains(t, query, "book_copies.status = 'available'")
+	assert.Contains(t, query, "books.publication_year >= $4")
+	assert.Contains(t, query, "books.publication_year <= $5")
+	assert.Contains(t, query, "LIMIT $2")
+	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"desert planet", int32(11), `100\%\_herbert`, int32(1960), int32(1970), "en"}, argsSlice)
+
+	mockPool.AssertExpectations(t)
+}
+
+// TestBookRepository_Search_AfterCursor tests that a page starts after the cursor's rank and id
+func TestBookRepository_Search_AfterCursor(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRows := &MockRows{}
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+	key := SearchKey(&pb.BookSearchResult{Rank: 0.1})
+
+	// Expectations
+	mockPool.On("Query", ctx, mock.Anything, mock.Anything).Return(mockRows, nil)
+	mockRows.On("Close").Return()
+
+	// Execute
+	results, err := repo.Search(ctx, BookSearch{Query: "dune"}, 11, &pagination.Cursor{Key: key, ID: "book-id-7"})
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+
+	query := mockPool.Calls[0].Arguments[1].(string)
+	assert.Contains(t, query, "< $3 OR (ts_rank_cd(books.search_vector, query) = $3 AND books.id > $4)")
+	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"dune", int32(11), float32(0.1), "book-id-7"}, argsSlice)
+
+	mockPool.AssertExpectations(t)
+}
+
+// TestBookRepository_Search_BadCursor tests that a cursor without a rank is rejected
+func TestBookRepository_Search_BadCursor(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+
+	repo := NewBookRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Execute
+	results, err := repo.Search(ctx, BookSearch{Query: "dune"}, 11, &pagination.Cursor{Key: "Dune", ID: "book-id-7"})
+
+	// Verify
+	assert.ErrorIs(t, err, pagination.ErrInvalidToken)
+	assert.Nil(t, results)
+	mockPool.AssertNotCalled(t, "Query", mock.Anything, mock.Anything, mock.Anything)
+}
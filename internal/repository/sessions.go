@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	pb "library-management-service/proto/library/v1"
+)
+
+var (
+	// ErrInvalidRefreshToken is returned for refresh tokens that are unknown, revoked or expired
+	ErrInvalidRefreshToken = newError(ErrUnauthenticated, "INVALID_REFRESH_TOKEN", "refresh token is invalid or has expired")
+	// ErrRefreshTokenReused is returned when a refresh token that was already rotated is presented
+	// again. Every session descending from the same login is revoked in response.
+	ErrRefreshTokenReused = newError(ErrUnauthenticated, "REFRESH_TOKEN_REUSED", "refresh token was already used; the session has been revoked")
+)
+
+// CreateSession starts a session for a user who just logged in. The refresh token is stored
+// hashed and starts a new token family.
+func (r *UserRepository) CreateSession(ctx context.Context, userID, token string, expiresAt time.Time) error {
+	_, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO sessions (family_id, user_id, token_hash, expires_at)
+		VALUES (gen_random_uuid(), $1, $2, $3)
+	`, userID, hashUserToken(token), expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+// RotateSession redeems a refresh token for newToken, which joins the same family and stays
+// valid until expiresAt, and returns the active user the session belongs to. Presenting a token
+// that was already rotated revokes the whole family and returns ErrRefreshTokenReused, since
+// either the legitimate client or an attacker is holding a stolen copy.
+func (r *UserRepository) RotateSession(ctx context.Context, token, newToken string, expiresAt time.Time) (*pb.User, error) {
+	var user *pb.User
+	reused := false
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Lock the presented token so that concurrent refreshes cannot both rotate it
+		var id, familyID, userID string
+		var rotated, revoked, expired bool
+		err := tx.QueryRow(ctx, `
+			SELECT id, family_id, user_id, rotated_at IS NOT NULL, revoked_at IS NOT NULL, expires_at <= NOW()
+			FROM sessions
+			WHERE token_hash = $1
+			FOR UPDATE
+		`, hashUserToken(token)).Scan(&id, &familyID, &userID, &rotated, &revoked, &expired)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidRefreshToken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get session: %w", err)
+		}
+
+		switch {
+		case revoked || expired:
+			return ErrInvalidRefreshToken
+		case rotated:
+			// The revocation must be committed, so the error is returned after the transaction
+			_, err = tx.Exec(ctx, `
+				UPDATE sessions SET revoked_at = NOW()
+				WHERE family_id = $1 AND revoked_at IS NULL
+			`, familyID)
+			if err != nil {
+				return fmt.Errorf("failed to revoke session: %w", err)
+			}
+			reused = true
+			return nil
+		}
+
+		user, err = scanUser(tx.QueryRow(ctx, `
+			SELECT `+userColumns+`
+			FROM users
+			WHERE id = $1 AND deactivated_at IS NULL
+		`, userID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidRefreshToken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE sessions SET rotated_at = NOW() WHERE id = $1`, id)
+		if err != nil {
+			return fmt.Errorf("failed to rotate session: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO sessions (family_id, user_id, token_hash, expires_at)
+			VALUES ($1, $2, $3, $4)
+		`, familyID, userID, hashUserToken(newToken), expiresAt)
+		if err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return nil, ErrRefreshTokenReused
+	}
+
+	return user, nil
+}
+
+// RevokeSession ends the session a valid refresh token belongs to, or every session of its user
+// when allSessions is set. Access tokens already issued stay valid until they expire.
+func (r *UserRepository) RevokeSession(ctx context.Context, token string, allSessions bool) error {
+	var found bool
+	err := r.db.Pool.QueryRow(ctx, `
+		WITH presented AS (
+			SELECT family_id, user_id FROM sessions
+			WHERE token_hash = $1 AND rotated_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()
+		), revoked AS (
+			UPDATE sessions SET revoked_at = NOW()
+			FROM presented
+			WHERE sessions.revoked_at IS NULL
+				AND (sessions.family_id = presented.family_id OR ($2 AND sessions.user_id = presented.user_id))
+			RETURNING sessions.id
+		)
+		SELECT EXISTS (SELECT 1 FROM presented)
+	`, hashUserToken(token), allSessions).Scan(&found)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if !found {
+		return ErrInvalidRefreshToken
+	}
+
+	return nil
+}
+
+// revokeUserSessions ends every session of a user, such as after their password changed
+func revokeUserSessions(ctx context.Context, tx pgx.Tx, userID string) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE sessions SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"library-management-service/internal/database"
+)
+
+// expectSession expects the presented refresh token to be looked up and locked
+func expectSession(ctx context.Context, mockTx *MockTx, rotated, revoked bool) {
+	mockSessionRow := new(MockRow)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockSessionRow).Once()
+	mockSessionRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "session-id-1"
+		*(dests[1].(*string)) = "family-id-1"
+		*(dests[2].(*string)) = "user-id-123"
+		*(dests[3].(*bool)) = rotated
+		*(dests[4].(*bool)) = revoked
+	}).Return(nil)
+}
+
+// TestUserRepository_CreateSession tests that only the hash of a refresh token is stored
+func TestUserRepository_CreateSession(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Test data
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	// Expectations
+	mockPool.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+
+	// Execute
+	err := repo.CreateSession(ctx, "user-id-123", "refresh-token", expiresAt)
+
+	// Verify
+	assert.NoError(t, err)
+
+	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", hashUserToken("refresh-token"), expiresAt}, argsSlice)
+	assert.Contains(t, mockPool.Calls[0].Arguments[1].(string), "gen_random_uuid()")
+
+	mockPool.AssertExpectations(t)
+}
+
+// TestUserRepository_RotateSession tests that a refresh token is replaced by one in the same family
+func TestUserRepository_RotateSession(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUserRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Test data
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectSession(ctx, mockTx, false, false)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUserRow).Once()
+	mockUserRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = "user-id-123"
+	}).Return(nil)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Twice()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	user, err := repo.RotateSession(ctx, "old-token", "new-token", expiresAt)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "user-id-123", user.Id)
+
+	assert.Contains(t, mockTx.Calls[0].Arguments[1].(string), "FOR UPDATE")
+	assert.Equal(t, []interface{}{hashUserToken("old-token")}, mockTx.Calls[0].Arguments[2].([]interface{}))
+	assert.Contains(t, mockTx.Calls[1].Arguments[1].(string), "deactivated_at IS NULL")
+	assert.Equal(t, []interface{}{"session-id-1"}, mockTx.Calls[2].Arguments[2].([]interface{}))
+
+	insertArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"family-id-1", "user-id-123", hashUserToken("new-token"), expiresAt}, insertArgsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_RotateSession_Reused tests that presenting a rotated token revokes its family
+func TestUserRepository_RotateSession_Reused(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations: the revocation is committed even though the refresh fails
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	expectSession(ctx, mockTx, true, false)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 2"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	user, err := repo.RotateSession(ctx, "stolen-token", "new-token", time.Now().Add(time.Hour))
+
+	// Verify
+	assert.ErrorIs(t, err, ErrRefreshTokenReused)
+	assert.Nil(t, user)
+
+	assert.Contains(t, mockTx.Calls[1].Arguments[1].(string), "WHERE family_id = $1")
+	assert.Equal(t, []interface{}{"family-id-1"}, mockTx.Calls[1].Arguments[2].([]interface{}))
+
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_RotateSession_Invalid tests that unknown and revoked tokens are refused
+func TestUserRepository_RotateSession_Invalid(t *testing.T) {
+	cases := map[string]func(ctx context.Context, mockTx *MockTx){
+		"Unknown": func(ctx context.Context, mockTx *MockTx) {
+			mockSessionRow := new(MockRow)
+			mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockSessionRow).Once()
+			mockSessionRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+		},
+		"Revoked": func(ctx context.Context, mockTx *MockTx) {
+			expectSession(ctx, mockTx, true, true)
+		},
+	}
+
+	for name, expect := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockTx := new(MockTx)
+
+		repo := NewUserRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+		expect(ctx, mockTx)
+		mockTx.On("Rollback", ctx).Return(nil).Once()
+
+		// Execute
+		user, err := repo.RotateSession(ctx, "old-token", "new-token", time.Now().Add(time.Hour))
+
+		// Verify
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken, name)
+		assert.Nil(t, user, name)
+		mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+	}
+}
+
+// TestUserRepository_RevokeSession tests logging out of one or all sessions
+func TestUserRepository_RevokeSession(t *testing.T) {
+	cases := map[string]struct {
+		allSessions bool
+		found       bool
+		err         error
+	}{
+		"This Session":   {false, true, nil},
+		"All Sessions":   {true, true, nil},
+		"Unknown Token":  {false, false, ErrInvalidRefreshToken},
+		"Unknown Logout": {true, false, ErrInvalidRefreshToken},
+	}
+
+	for name, tc := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockRow := new(MockRow)
+
+		repo := NewUserRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+		mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*(args.Get(0).([]interface{})[0].(*bool)) = tc.found
+		}).Return(nil)
+
+		// Execute
+		err := repo.RevokeSession(ctx, "refresh-token", tc.allSessions)
+
+		// Verify
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, name)
+		} else {
+			assert.NoError(t, err, name)
+		}
+		argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+		assert.Equal(t, []interface{}{hashUserToken("refresh-token"), tc.allSessions}, argsSlice, name)
+	}
+}
@@ -10,7 +10,26 @@ import (
 	"library-management-service/internal/database"
 	pb "library-management-service/proto/library/v1"
 )
-	
+
+var (
+	ErrUserNotFound   = newError(ErrNotFound, "USER_NOT_FOUND", "user not found")
+	ErrDuplicateEmail = newError(ErrDuplicate, "DUPLICATE_EMAIL", "email is already registered")
+	ErrWrongPassword  = newError(ErrForbidden, "WRONG_PASSWORD", "current password is incorrect")
+	// ErrInvalidCredentials is returned by VerifyCredentials for unknown emails and wrong
+	// passwords alike
+	ErrInvalidCredentials = newError(ErrUnauthenticated, "INVALID_CREDENTIALS", "invalid credentials")
+)
+
+// unknownUserPasswordHash is compared against when no user has the email being logged in with,
+// so that unknown emails take as long to check as wrong passwords. It uses bcrypt.DefaultCost,
+// like the hashes of stored passwords.
+const unknownUserPasswordHash = "$2a$10$oeJCALR9haBzHUl1jRQ37.kVHOmt2G3uESg.mmMbhNwQ4i6PYRkBK"
+
+// userColumns are the columns scanUser reads, in order
+const userColumns = `users.id, users.name, users.email,
+	ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role), users.patron_type,
+	users.email_verified, users.deactivated_at IS NULL`
+
 type UserRepository struct {
 	db *database.DB
 }
@@ -26,16 +45,27 @@ func (r *UserRepository) Create(ctx context.Context, name, email, password strin
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
 
-	var user pb.User
+	// New users start out as patrons
+	user := pb.User{Active: true}
 	err = r.db.Pool.QueryRow(ctx, `
-		INSERT INTO users (name, email, password_hash)
-		VALUES ($1, $2, $3)
-		RETURNING id, name, email
-	`, name, email, string(hashedPassword)).Scan(&user.Id, &user.Name, &user.Email)
+		WITH created AS (
+			INSERT INTO users (name, email, password_hash)
+			VALUES ($1, $2, $3)
+			RETURNING id, name, email, patron_type
+		), granted AS (
+			INSERT INTO user_roles (user_id, role)
+			SELECT id, $4 FROM created
+		)
+		SELECT id, name, email, patron_type FROM created
+	`, name, email, string(hashedPassword), RoleName(pb.Role_ROLE_PATRON)).Scan(&user.Id, &user.Name, &user.Email, &user.PatronType)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrDuplicateEmail
+		}
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
+	user.Roles = []pb.Role{pb.Role_ROLE_PATRON}
 
 	return &user, nil
 }
@@ -43,16 +73,23 @@ func (r *UserRepository) Create(ctx context.Context, name, email, password strin
 func (r *UserRepository) VerifyCredentials(ctx context.Context, email, password string) (*pb.User, error) {
 	var user pb.User
 	var passwordHash string
+	var roles []string
 
+	// Deactivated users cannot log in
 	err := r.db.Pool.QueryRow(ctx, `
-		SELECT id, name, email, password_hash 
+		SELECT id, name, email, password_hash,
+			ARRAY(SELECT role FROM user_roles WHERE user_id = users.id ORDER BY role), patron_type,
+			email_verified
 		FROM users 
-		WHERE email = $1
-	`, email).Scan(&user.Id, &user.Name, &user.Email, &passwordHash)
+		WHERE email = $1 AND deactivated_at IS NULL
+	`, email).Scan(&user.Id, &user.Name, &user.Email, &passwordHash, &roles, &user.PatronType, &user.EmailVerified)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("invalid credentials")
+			// Spend the same time as for a wrong password, so that timing does not reveal
+			// which emails are registered
+			_ = bcrypt.CompareHashAndPassword([]byte(unknownUserPasswordHash), []byte(password))
+			return nil, ErrInvalidCredentials
 		}
 		return nil, fmt.Errorf("database error: %w", err)
 	}
@@ -60,27 +97,102 @@ func (r *UserRepository) VerifyCredentials(ctx context.Context, email, password
 	// Compare hashed password with provided password
 	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
 	if err != nil {
-		return nil, fmt.Errorf("invalid credentials")
+		return nil, ErrInvalidCredentials
 	}
+	user.Roles = rolesFromNames(roles)
+	user.Active = true
 
 	return &user, nil
 }
 
+// GetByID returns a user, including a deactivated one
 func (r *UserRepository) GetByID(ctx context.Context, id string) (*pb.User, error) {
-	var user pb.User
-
-	err := r.db.Pool.QueryRow(ctx, `
-		SELECT id, name, email 
+	user, err := scanUser(r.db.Pool.QueryRow(ctx, `
+		SELECT `+userColumns+`
 		FROM users 
 		WHERE id = $1
-	`, id).Scan(&user.Id, &user.Name, &user.Email)
+	`, id))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return user, nil
+}
+
+func (r *UserRepository) GrantRole(ctx context.Context, userID string, role pb.Role) (*pb.User, error) {
+	_, err := r.db.Pool.Exec(ctx, `
+		INSERT INTO user_roles (user_id, role)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, role) DO NOTHING
+	`, userID, RoleName(role))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to grant role: %w", err)
+	}
+
+	return r.GetByID(ctx, userID)
+}
 
+// GrantRoleByEmail grants role to the active user registered with email. It bootstraps the first
+// admin, since GrantRole can only be called by an admin through the API.
+func (r *UserRepository) GrantRoleByEmail(ctx context.Context, email string, role pb.Role) (*pb.User, error) {
+	var userID string
+	err := r.db.Pool.QueryRow(ctx, `
+		SELECT id FROM users
+		WHERE email = $1 AND deactivated_at IS NULL
+	`, email).Scan(&userID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("user not found")
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
+	return r.GrantRole(ctx, userID, role)
+}
+
+func (r *UserRepository) RevokeRole(ctx context.Context, userID string, role pb.Role) (*pb.User, error) {
+	_, err := r.db.Pool.Exec(ctx, `
+		DELETE FROM user_roles
+		WHERE user_id = $1 AND role = $2
+	`, userID, RoleName(role))
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke role: %w", err)
+	}
+
+	return r.GetByID(ctx, userID)
+}
+
+// SetPatronType changes the patron type that selects the user's circulation policy
+func (r *UserRepository) SetPatronType(ctx context.Context, userID, patronType string) (*pb.User, error) {
+	tag, err := r.db.Pool.Exec(ctx, `
+		UPDATE users SET patron_type = $2, updated_at = NOW()
+		WHERE id = $1
+	`, userID, patronType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set patron type: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrUserNotFound
+	}
+
+	return r.GetByID(ctx, userID)
+}
+
+// scanUser reads a user selected with userColumns
+func scanUser(row pgx.Row) (*pb.User, error) {
+	var user pb.User
+	var roles []string
+	err := row.Scan(&user.Id, &user.Name, &user.Email, &roles, &user.PatronType, &user.EmailVerified, &user.Active)
+	if err != nil {
+		return nil, err
+	}
+	user.Roles = rolesFromNames(roles)
 	return &user, nil
 }
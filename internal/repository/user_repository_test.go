@@ -14,6 +14,7 @@ import (
 
 	"library-management-service/internal/database"
 	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
 )
 
 // MockRow implements a mock for database row
@@ -36,6 +37,16 @@ func (m *MockPgxPool) Acquire(ctx context.Context) (*pgxpool.Conn, error) {
 	return args.Get(0).(*pgxpool.Conn), args.Error(1)
 }
 
+func (m *MockPgxPool) Begin(ctx context.Context) (pgx.Tx, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
+func (m *MockPgxPool) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	args := m.Called(ctx, txOptions)
+	return args.Get(0).(pgx.Tx), args.Error(1)
+}
+
 func (m *MockPgxPool) Close() {
 	m.Called()
 }
@@ -359,7 +370,7 @@ func TestUserRepository_VerifyCredentials_UserNotFound(t *testing.T) {
 	user, err := repo.VerifyCredentials(ctx, email, password)
 
 	// Verify
-	assert.Error(t, err)
+	assert.ErrorIs(t, err, repository.ErrInvalidCredentials)
 	assert.Nil(t, user)
 	assert.Contains(t, err.Error(), "invalid credentials")
 
@@ -454,3 +465,146 @@ func TestUserRepository_VerifyCredentials_DatabaseError(t *testing.T) {
 	mockPool.AssertExpectations(t)
 	mockRow.AssertExpectations(t)
 }
+
+func TestUserRepository_GrantRole(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := repository.NewUserRepository(db)
+	ctx := context.Background()
+
+	// Test data
+	userID := "user-id-123"
+
+	// Expectations - insert the role, then reload the user with its roles
+	mockPool.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+	mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = userID
+		*(dests[1].(*string)) = "Test User"
+		*(dests[2].(*string)) = "test@example.com"
+		*(dests[3].(*[]string)) = []string{"librarian", "patron"}
+	}).Return(nil)
+
+	// Execute
+	user, err := repo.GrantRole(ctx, userID, pb.Role_ROLE_LIBRARIAN)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.Role{pb.Role_ROLE_LIBRARIAN, pb.Role_ROLE_PATRON}, user.Roles)
+
+	// Verify the role was stored under its name
+	argsSlice := mockPool.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, userID, argsSlice[0])
+	assert.Equal(t, "librarian", argsSlice[1])
+
+	mockPool.AssertExpectations(t)
+	mockRow.AssertExpectations(t)
+}
+
+func TestUserRepository_GrantRole_UnknownUser(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := repository.NewUserRepository(db)
+	ctx := context.Background()
+
+	// Expectations - the role cannot reference a missing user
+	mockPool.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag(""), &pgconn.PgError{Code: "23503"})
+
+	// Execute
+	user, err := repo.GrantRole(ctx, "user-id-404", pb.Role_ROLE_LIBRARIAN)
+
+	// Verify
+	assert.Nil(t, user)
+	assert.ErrorIs(t, err, repository.ErrUserNotFound)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	mockPool.AssertNotCalled(t, "QueryRow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserRepository_GrantRoleByEmail(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	lookupRow := new(MockRow)
+	userRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := repository.NewUserRepository(db)
+	ctx := context.Background()
+
+	// Test data
+	userID := "user-id-123"
+	email := "admin@example.com"
+
+	// Expectations - look up the user by email, then grant the role by id
+	mockPool.On("QueryRow", ctx, mock.Anything, []interface{}{email}).Return(lookupRow).Once()
+	lookupRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = userID
+	}).Return(nil)
+	mockPool.On("Exec", ctx, mock.Anything, []interface{}{userID, "admin"}).Return(pgconn.CommandTag("INSERT 0 1"), nil)
+	mockPool.On("QueryRow", ctx, mock.Anything, []interface{}{userID}).Return(userRow).Once()
+	userRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = userID
+		*(dests[2].(*string)) = email
+		*(dests[3].(*[]string)) = []string{"admin", "patron"}
+	}).Return(nil)
+
+	// Execute
+	user, err := repo.GrantRoleByEmail(ctx, email, pb.Role_ROLE_ADMIN)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, userID, user.Id)
+	assert.Equal(t, []pb.Role{pb.Role_ROLE_ADMIN, pb.Role_ROLE_PATRON}, user.Roles)
+
+	mockPool.AssertExpectations(t)
+	lookupRow.AssertExpectations(t)
+	userRow.AssertExpectations(t)
+}
+
+func TestUserRepository_GrantRoleByEmail_UnknownEmail(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockRow := new(MockRow)
+
+	db := &database.DB{
+		Pool: mockPool,
+	}
+
+	repo := repository.NewUserRepository(db)
+	ctx := context.Background()
+
+	// Expectations - no active user has the email
+	mockPool.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRow)
+	mockRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+
+	// Execute
+	user, err := repo.GrantRoleByEmail(ctx, "nobody@example.com", pb.Role_ROLE_ADMIN)
+
+	// Verify
+	assert.Nil(t, user)
+	assert.ErrorIs(t, err, repository.ErrUserNotFound)
+	mockPool.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRoleNames(t *testing.T) {
+	assert.Equal(t, "admin", repository.RoleName(pb.Role_ROLE_ADMIN))
+	assert.Equal(t, pb.Role_ROLE_LIBRARIAN, repository.RoleFromName("librarian"))
+	assert.Equal(t, pb.Role_ROLE_UNSPECIFIED, repository.RoleFromName("janitor"))
+	assert.Equal(t, []string{"patron", "admin"}, repository.RoleNames([]pb.Role{pb.Role_ROLE_PATRON, pb.Role_ROLE_ADMIN}))
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"golang.org/x/crypto/bcrypt"
+	pb "library-management-service/proto/library/v1"
+)
+
+var (
+	// ErrInvalidResetToken is returned for password reset tokens that are unknown, used or expired
+	ErrInvalidResetToken = newError(ErrInvalid, "INVALID_RESET_TOKEN", "password reset token is invalid or has expired")
+	// ErrInvalidVerificationToken is returned for email verification tokens that are unknown,
+	// used, expired or were mailed to an address the user no longer has
+	ErrInvalidVerificationToken = newError(ErrInvalid, "INVALID_VERIFICATION_TOKEN", "email verification token is invalid or has expired")
+	ErrEmailAlreadyVerified     = newError(ErrConflict, "EMAIL_ALREADY_VERIFIED", "email is already verified")
+	ErrVerificationRateLimited  = newError(ErrRateLimited, "VERIFICATION_RATE_LIMITED", "a verification email was sent recently")
+	ErrPasswordResetRateLimited = newError(ErrRateLimited, "PASSWORD_RESET_RATE_LIMITED", "a password reset was sent recently")
+)
+
+// Purposes of user tokens
+const (
+	purposePasswordReset     = "password_reset"
+	purposeEmailVerification = "email_verification"
+)
+
+// hashUserToken returns the hash under which a user token is stored
+func hashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreatePasswordReset stores a reset token for the active user registered with email and returns
+// the user, or ErrUserNotFound when there is none. Users who have not verified their email are
+// not found either: the address may not be theirs. The token is stored hashed and can be
+// redeemed once with ResetPassword until expiresAt. It refuses with ErrPasswordResetRateLimited
+// when a reset was requested for the user less than interval ago.
+func (r *UserRepository) CreatePasswordReset(ctx context.Context, email, token string, expiresAt time.Time, interval time.Duration) (*pb.User, error) {
+	var user *pb.User
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Lock the user so that concurrent requests cannot both pass the rate limit
+		var err error
+		user, err = scanUser(tx.QueryRow(ctx, `
+			SELECT `+userColumns+`
+			FROM users
+			WHERE email = $1 AND email_verified AND deactivated_at IS NULL
+			FOR UPDATE
+		`, email))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		var recent bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM user_tokens
+				WHERE user_id = $1 AND purpose = $2 AND created_at > NOW() - make_interval(secs => $3)
+			)
+		`, user.Id, purposePasswordReset, interval.Seconds()).Scan(&recent)
+		if err != nil {
+			return fmt.Errorf("failed to check recent password resets: %w", err)
+		}
+		if recent {
+			return ErrPasswordResetRateLimited
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO user_tokens (user_id, purpose, token_hash, expires_at)
+			VALUES ($1, $2, $3, $4)
+		`, user.Id, purposePasswordReset, hashUserToken(token), expiresAt)
+		if err != nil {
+			return fmt.Errorf("failed to create password reset: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ResetPassword redeems a password reset token, replacing the password of the user it was
+// issued to. Redeeming a token also invalidates the user's other outstanding reset tokens and
+// ends all of their sessions.
+func (r *UserRepository) ResetPassword(ctx context.Context, token, newPassword string) error {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Marking the token used with a conditional update lets only one of several concurrent
+		// attempts redeem it
+		var userID string
+		err := tx.QueryRow(ctx, `
+			UPDATE user_tokens SET used_at = NOW()
+			WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+			RETURNING user_id
+		`, hashUserToken(token), purposePasswordReset).Scan(&userID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidResetToken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to redeem reset token: %w", err)
+		}
+
+		tag, err := tx.Exec(ctx, `
+			UPDATE users SET password_hash = $2, updated_at = NOW()
+			WHERE id = $1 AND deactivated_at IS NULL
+		`, userID, string(hashedPassword))
+		if err != nil {
+			return fmt.Errorf("failed to reset password: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrInvalidResetToken
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE user_tokens SET used_at = NOW()
+			WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL
+		`, userID, purposePasswordReset)
+		if err != nil {
+			return fmt.Errorf("failed to invalidate reset tokens: %w", err)
+		}
+		return revokeUserSessions(ctx, tx, userID)
+	})
+}
+
+// CreateEmailVerification stores a verification token for the current email of an active,
+// unverified user and returns the user. It refuses with ErrVerificationRateLimited when the user
+// was issued another verification token less than interval ago.
+func (r *UserRepository) CreateEmailVerification(ctx context.Context, userID, token string, expiresAt time.Time, interval time.Duration) (*pb.User, error) {
+	var user *pb.User
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		// Lock the user so that concurrent requests cannot both pass the rate limit
+		var err error
+		user, err = scanUser(tx.QueryRow(ctx, `
+			SELECT `+userColumns+`
+			FROM users
+			WHERE id = $1 AND deactivated_at IS NULL
+			FOR UPDATE
+		`, userID))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if user.EmailVerified {
+			return ErrEmailAlreadyVerified
+		}
+
+		var recent bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM user_tokens
+				WHERE user_id = $1 AND purpose = $2 AND created_at > NOW() - make_interval(secs => $3)
+			)
+		`, userID, purposeEmailVerification, interval.Seconds()).Scan(&recent)
+		if err != nil {
+			return fmt.Errorf("failed to check recent verifications: %w", err)
+		}
+		if recent {
+			return ErrVerificationRateLimited
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO user_tokens (user_id, purpose, token_hash, email, expires_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, userID, purposeEmailVerification, hashUserToken(token), user.Email, expiresAt)
+		if err != nil {
+			return fmt.Errorf("failed to create email verification: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// VerifyEmail redeems an email verification token, marking the address it was mailed to as
+// verified provided that the user still has it, and returns the user
+func (r *UserRepository) VerifyEmail(ctx context.Context, token string) (*pb.User, error) {
+	var user *pb.User
+	err := r.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var userID, email string
+		err := tx.QueryRow(ctx, `
+			UPDATE user_tokens SET used_at = NOW()
+			WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+			RETURNING user_id, email
+		`, hashUserToken(token), purposeEmailVerification).Scan(&userID, &email)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidVerificationToken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to redeem verification token: %w", err)
+		}
+
+		user, err = scanUser(tx.QueryRow(ctx, `
+			UPDATE users SET email_verified = TRUE, updated_at = NOW()
+			WHERE id = $1 AND email = $2 AND deactivated_at IS NULL
+			RETURNING `+userColumns+`
+		`, userID, email))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrInvalidVerificationToken
+		}
+		if err != nil {
+			return fmt.Errorf("failed to verify email: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE user_tokens SET used_at = NOW()
+			WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL
+		`, userID, purposeEmailVerification)
+		if err != nil {
+			return fmt.Errorf("failed to invalidate verification tokens: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
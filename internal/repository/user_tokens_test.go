@@ -0,0 +1,345 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"library-management-service/internal/database"
+)
+
+// TestUserRepository_CreatePasswordReset tests that only the hash of a reset token is stored
+func TestUserRepository_CreatePasswordReset(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUserRow := new(MockRow)
+	mockRecentRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Test data
+	expiresAt := time.Now().Add(time.Hour)
+
+	// Expectations: an active user without a recent reset
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, []interface{}{"ada@example.com"}).Return(mockUserRow).Once()
+	mockUserRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "user-id-123"
+		*(dests[2].(*string)) = "ada@example.com"
+	}).Return(nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRecentRow).Once()
+	mockRecentRow.On("Scan", mock.Anything).Return(nil)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("INSERT 0 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	user, err := repo.CreatePasswordReset(ctx, "ada@example.com", "secret-token", expiresAt, time.Minute)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "user-id-123", user.Id)
+
+	assert.Contains(t, mockTx.Calls[0].Arguments[1], "email_verified", "unverified emails are not mailed a reset")
+	recentArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "password_reset", float64(60)}, recentArgsSlice)
+	insertArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "password_reset", hashUserToken("secret-token"), expiresAt}, insertArgsSlice)
+	assert.NotContains(t, insertArgsSlice, "secret-token")
+	assert.Len(t, insertArgsSlice[2], 64)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_CreatePasswordReset_Refused tests that no token is created without a user,
+// or when a reset was requested for the user recently
+func TestUserRepository_CreatePasswordReset_Refused(t *testing.T) {
+	cases := map[string]struct {
+		registered bool
+		err        error
+	}{
+		"Unknown Email": {registered: false, err: ErrUserNotFound},
+		"Sent Recently": {registered: true, err: ErrPasswordResetRateLimited},
+	}
+
+	for name, tc := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockTx := new(MockTx)
+		mockUserRow := new(MockRow)
+
+		repo := NewUserRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUserRow).Once()
+		if tc.registered {
+			mockUserRow.On("Scan", mock.Anything).Return(nil)
+			mockRecentRow := new(MockRow)
+			mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRecentRow).Once()
+			mockRecentRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+				*(args.Get(0).([]interface{})[0].(*bool)) = true
+			}).Return(nil)
+		} else {
+			mockUserRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+		}
+		mockTx.On("Rollback", ctx).Return(nil).Once()
+
+		// Execute
+		user, err := repo.CreatePasswordReset(ctx, "ada@example.com", "secret-token", time.Now().Add(time.Hour), time.Minute)
+
+		// Verify
+		assert.ErrorIs(t, err, tc.err, name)
+		assert.Nil(t, user, name)
+		mockTx.AssertExpectations(t)
+		mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+// TestUserRepository_ResetPassword tests that redeeming a token replaces the password,
+// invalidates the user's other tokens and ends their sessions
+func TestUserRepository_ResetPassword(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockTokenRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockTokenRow).Once()
+	mockTokenRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*(args.Get(0).([]interface{})[0].(*string)) = "user-id-123"
+	}).Return(nil)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 1"), nil).Times(3)
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	err := repo.ResetPassword(ctx, "secret-token", "correct horse")
+
+	// Verify
+	assert.NoError(t, err)
+
+	tokenQuery := mockTx.Calls[0].Arguments[1].(string)
+	assert.Contains(t, tokenQuery, "used_at IS NULL AND expires_at > NOW()")
+	tokenArgsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{hashUserToken("secret-token"), "password_reset"}, tokenArgsSlice)
+
+	passwordArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, "user-id-123", passwordArgsSlice[0])
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(passwordArgsSlice[1].(string)), []byte("correct horse")))
+
+	invalidateArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "password_reset"}, invalidateArgsSlice)
+
+	revokeArgsSlice := mockTx.Calls[3].Arguments[2].([]interface{})
+	assert.Contains(t, mockTx.Calls[3].Arguments[1].(string), "UPDATE sessions SET revoked_at = NOW()")
+	assert.Equal(t, []interface{}{"user-id-123"}, revokeArgsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_ResetPassword_InvalidToken tests that used, expired or unknown tokens are refused
+func TestUserRepository_ResetPassword_InvalidToken(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockTokenRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockTokenRow).Once()
+	mockTokenRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	err := repo.ResetPassword(ctx, "used-token", "correct horse")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrInvalidResetToken)
+	mockTx.AssertExpectations(t)
+	mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
+
+// TestUserRepository_CreateEmailVerification tests that the token is bound to the current email
+func TestUserRepository_CreateEmailVerification(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockUserRow := new(MockRow)
+	mockRecentRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Test data
+	expiresAt := time.Now().Add(48 * time.Hour)
+
+	// Expectations: an unverified user without a recent verification
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUserRow).Once()
+	mockUserRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "user-id-123"
+		*(dests[2].(*string)) = "ada@example.com"
+	}).Return(nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRecentRow).Once()
+	mockRecentRow.On("Scan", mock.Anything).Return(nil)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("INSERT 0 1"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	user, err := repo.CreateEmailVerification(ctx, "user-id-123", "secret-token", expiresAt, time.Minute)
+
+	// Verify
+	assert.NoError(t, err)
+	assert.Equal(t, "ada@example.com", user.Email)
+
+	recentArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "email_verification", float64(60)}, recentArgsSlice)
+	insertArgsSlice := mockTx.Calls[2].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "email_verification", hashUserToken("secret-token"), "ada@example.com", expiresAt}, insertArgsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_CreateEmailVerification_Refused tests that verified users and users who were
+// sent a verification recently are not sent another
+func TestUserRepository_CreateEmailVerification_Refused(t *testing.T) {
+	cases := map[string]struct {
+		verified bool
+		recent   bool
+		err      error
+	}{
+		"Already Verified": {verified: true, err: ErrEmailAlreadyVerified},
+		"Sent Recently":    {recent: true, err: ErrVerificationRateLimited},
+	}
+
+	for name, tc := range cases {
+		// Setup
+		mockPool := new(MockPgxPool)
+		mockTx := new(MockTx)
+		mockUserRow := new(MockRow)
+
+		repo := NewUserRepository(&database.DB{Pool: mockPool})
+		ctx := context.Background()
+
+		// Expectations
+		mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+		mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUserRow).Once()
+		mockUserRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*(args.Get(0).([]interface{})[5].(*bool)) = tc.verified
+		}).Return(nil)
+		if !tc.verified {
+			mockRecentRow := new(MockRow)
+			mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockRecentRow).Once()
+			mockRecentRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+				*(args.Get(0).([]interface{})[0].(*bool)) = tc.recent
+			}).Return(nil)
+		}
+		mockTx.On("Rollback", ctx).Return(nil).Once()
+
+		// Execute
+		user, err := repo.CreateEmailVerification(ctx, "user-id-123", "secret-token", time.Now().Add(time.Hour), time.Minute)
+
+		// Verify
+		assert.ErrorIs(t, err, tc.err, name)
+		assert.Nil(t, user, name)
+		mockTx.AssertExpectations(t)
+		mockTx.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+// TestUserRepository_VerifyEmail tests that only the address the token was mailed to is verified
+func TestUserRepository_VerifyEmail(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockTokenRow := new(MockRow)
+	mockUserRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockTokenRow).Once()
+	mockTokenRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "user-id-123"
+		*(dests[1].(*string)) = "ada@example.com"
+	}).Return(nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUserRow).Once()
+	mockUserRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		dests := args.Get(0).([]interface{})
+		*(dests[0].(*string)) = "user-id-123"
+		*(dests[5].(*bool)) = true
+	}).Return(nil)
+	mockTx.On("Exec", ctx, mock.Anything, mock.Anything).Return(pgconn.CommandTag("UPDATE 0"), nil).Once()
+	mockTx.On("Commit", ctx).Return(nil).Once()
+	mockTx.On("Rollback", ctx).Return(pgx.ErrTxClosed).Once()
+
+	// Execute
+	user, err := repo.VerifyEmail(ctx, "secret-token")
+
+	// Verify
+	assert.NoError(t, err)
+	assert.True(t, user.EmailVerified)
+
+	tokenArgsSlice := mockTx.Calls[0].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{hashUserToken("secret-token"), "email_verification"}, tokenArgsSlice)
+	assert.Contains(t, mockTx.Calls[1].Arguments[1].(string), "WHERE id = $1 AND email = $2")
+	userArgsSlice := mockTx.Calls[1].Arguments[2].([]interface{})
+	assert.Equal(t, []interface{}{"user-id-123", "ada@example.com"}, userArgsSlice)
+
+	mockPool.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+// TestUserRepository_VerifyEmail_EmailChanged tests that a token mailed to a previous address is refused
+func TestUserRepository_VerifyEmail_EmailChanged(t *testing.T) {
+	// Setup
+	mockPool := new(MockPgxPool)
+	mockTx := new(MockTx)
+	mockTokenRow := new(MockRow)
+	mockUserRow := new(MockRow)
+
+	repo := NewUserRepository(&database.DB{Pool: mockPool})
+	ctx := context.Background()
+
+	// Expectations: the token is valid but the user has another address now
+	mockPool.On("BeginTx", ctx, pgx.TxOptions{}).Return(mockTx, nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockTokenRow).Once()
+	mockTokenRow.On("Scan", mock.Anything).Return(nil)
+	mockTx.On("QueryRow", ctx, mock.Anything, mock.Anything).Return(mockUserRow).Once()
+	mockUserRow.On("Scan", mock.Anything).Return(pgx.ErrNoRows)
+	mockTx.On("Rollback", ctx).Return(nil).Once()
+
+	// Execute
+	user, err := repo.VerifyEmail(ctx, "old-token")
+
+	// Verify
+	assert.ErrorIs(t, err, ErrInvalidVerificationToken)
+	assert.Nil(t, user)
+	mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+}
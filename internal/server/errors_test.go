@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHandleError tests that service errors are rendered as RFC 7807 problem details
+func TestHandleError(t *testing.T) {
+	unavailable, _ := status.New(codes.FailedPrecondition, "book is not available").
+		WithDetails(&errdetails.ErrorInfo{Reason: "BOOK_UNAVAILABLE", Domain: "library"})
+
+	cases := map[string]struct {
+		err  error
+		want problem
+	}{
+		"Domain Error": {unavailable.Err(), problem{
+			Type: "about:blank", Title: "Conflict", Status: http.StatusConflict,
+			Detail: "book is not available", Instance: "/api/books/book-id-1/borrowBook", Reason: "BOOK_UNAVAILABLE",
+		}},
+		"Unauthenticated": {status.Error(codes.Unauthenticated, "invalid or missing token"), problem{
+			Type: "about:blank", Title: "Unauthorized", Status: http.StatusUnauthorized,
+			Detail: "invalid or missing token", Instance: "/api/books/book-id-1/borrowBook",
+		}},
+		"Invalid Argument": {status.Error(codes.InvalidArgument, "invalid email format"), problem{
+			Type: "about:blank", Title: "Bad Request", Status: http.StatusBadRequest,
+			Detail: "invalid email format", Instance: "/api/books/book-id-1/borrowBook",
+		}},
+		// Internal failures are logged, not shown to clients
+		"Internal": {errors.New("connection refused"), problem{
+			Type: "about:blank", Title: "Internal Server Error", Status: http.StatusInternalServerError,
+			Instance: "/api/books/book-id-1/borrowBook",
+		}},
+	}
+
+	for name, tc := range cases {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPost, "/api/books/book-id-1/borrowBook", nil)
+
+		handleError(context.Background(), nil, nil, recorder, request, tc.err)
+
+		var body problem
+		assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body), name)
+		assert.Equal(t, tc.want, body, name)
+		assert.Equal(t, tc.want.Status, recorder.Code, name)
+		assert.Equal(t, problemContentType, recorder.Header().Get("Content-Type"), name)
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	swaggerFiles "github.com/swaggo/files/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	pb "library-management-service/proto/library/v1"
+)
+
+// swaggerInitializer starts the Swagger UI on the OpenAPI document of the REST API
+const swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: "/openapi.json",
+    dom_id: "#swagger-ui",
+    deepLinking: true,
+    presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+    layout: "StandaloneLayout"
+  });
+};
+`
+
+// gatewayMarshaler encodes messages with their proto field names and zero values, and ignores
+// unknown fields in request bodies
+var gatewayMarshaler = &runtime.JSONPb{
+	MarshalOptions: protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	},
+	UnmarshalOptions: protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	},
+}
+
+// NewGateway returns the handler serving the REST mapping of the LibraryService, as declared by
+// the google.api.http annotations in library.proto. Requests are relayed over conn to the gRPC
+// server, so that they pass the same authentication and interceptors as gRPC calls. The OpenAPI
+// document of the routes is served at /openapi.json and browsed with the Swagger UI at /docs.
+func NewGateway(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	gateway := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaler),
+		runtime.WithErrorHandler(handleError),
+		runtime.WithRoutingErrorHandler(handleRoutingError),
+		runtime.WithForwardResponseOption(setResponseStatus),
+		runtime.WithForwardResponseRewriter(flattenCreatedBook),
+	)
+	if err := pb.RegisterLibraryServiceHandler(ctx, gateway, conn); err != nil {
+		return nil, err
+	}
+
+	document, err := json.Marshal(newOpenAPIDocument())
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gateway)
+	mux.Handle("POST /api/books", wrapCreateBookBody(gateway))
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	})
+	mux.Handle("GET /docs/", http.StripPrefix("/docs/", http.FileServerFS(swaggerFiles.FS)))
+	mux.HandleFunc("GET /docs/swagger-initializer.js", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+		_, _ = w.Write([]byte(swaggerInitializer))
+	})
+	return mux, nil
+}
+
+// setResponseStatus sets the status of successful responses that are not 200 OK
+func setResponseStatus(_ context.Context, w http.ResponseWriter, response proto.Message) error {
+	// Responses with a response_body are wrapped by the gateway; ProtoReflect reaches the RPC's
+	if code := successStatus(response.ProtoReflect().Interface()); code != http.StatusOK {
+		w.WriteHeader(code)
+	}
+	return nil
+}
+
+// successStatus answers requests that create a resource with 201 Created, requests whose
+// outcome is mailed later with 202 Accepted and requests without a result with 204 No Content
+func successStatus(response proto.Message) int {
+	switch response.(type) {
+	case *pb.RegisterUserResponse, *pb.CreateBookResponse, *pb.AddBookCopyResponse,
+		*pb.PlaceHoldResponse, *pb.RecordPaymentResponse:
+		return http.StatusCreated
+	case *pb.RequestPasswordResetResponse, *pb.ResendVerificationEmailResponse:
+		return http.StatusAccepted
+	case *pb.LogoutResponse, *pb.ConfirmPasswordResetResponse, *pb.ChangePasswordResponse,
+		*pb.UnlockLoginResponse, *pb.DeleteBookResponse:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
+
+// wrapCreateBookBody accepts the body of POST /api/books as the REST API took it before the
+// gateway: the fields of the book at the top level, next to its initial copies. It is moved into
+// the book field of a CreateBookRequest; bodies that already have a book field are left as they are.
+func wrapCreateBookBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeProblem(w, r, http.StatusBadRequest, problem{Detail: "could not read the request body"})
+			return
+		}
+
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(body, &fields) == nil && fields != nil && fields["book"] == nil {
+			request := map[string]json.RawMessage{"copies": fields["copies"]}
+			delete(fields, "copies")
+			if request["copies"] == nil {
+				delete(request, "copies")
+			}
+			if request["book"], err = json.Marshal(fields); err == nil {
+				body, _ = json.Marshal(request)
+			}
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// flattenCreatedBook renders a CreateBookResponse as the REST API did before the gateway: the
+// book with its copies added. Other responses are left to the marshaler.
+func flattenCreatedBook(_ context.Context, response proto.Message) (any, error) {
+	created, ok := response.(*pb.CreateBookResponse)
+	if !ok {
+		return response, nil
+	}
+
+	book, err := gatewayMarshaler.Marshal(created.GetBook())
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(book, &fields); err != nil {
+		return nil, err
+	}
+	if fields["copies"], err = gatewayMarshaler.Marshal(created.GetCopies()); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
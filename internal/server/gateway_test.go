@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	pb "library-management-service/proto/library/v1"
+)
+
+// stubLibraryService records the requests it receives and answers a few RPCs
+type stubLibraryService struct {
+	pb.UnimplementedLibraryServiceServer
+	requests []proto.Message
+}
+
+func (s *stubLibraryService) GetUser(_ context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	s.requests = append(s.requests, req)
+	return &pb.GetUserResponse{User: &pb.User{Id: "user-id-1", Name: "Ada", Active: true}}, nil
+}
+
+func (s *stubLibraryService) RegisterUser(_ context.Context, req *pb.RegisterUserRequest) (*pb.RegisterUserResponse, error) {
+	s.requests = append(s.requests, req)
+	return &pb.RegisterUserResponse{User: &pb.User{Id: "user-id-1", Name: req.Name, Email: req.Email}}, nil
+}
+
+func (s *stubLibraryService) CreateBook(_ context.Context, req *pb.CreateBookRequest) (*pb.CreateBookResponse, error) {
+	s.requests = append(s.requests, req)
+	copies := []*pb.BookCopy{{Id: "copy-id-1", BookId: "book-id-1", Barcode: "B-1"}}
+	return &pb.CreateBookResponse{Book: &pb.Book{Id: "book-id-1", Title: req.Book.Title, PublicationYear: 1965}, Copies: copies}, nil
+}
+
+func (s *stubLibraryService) SearchBooks(_ context.Context, req *pb.SearchBooksRequest) (*pb.SearchBooksResponse, error) {
+	s.requests = append(s.requests, req)
+	return &pb.SearchBooksResponse{}, nil
+}
+
+func (s *stubLibraryService) UpdateBook(_ context.Context, req *pb.UpdateBookRequest) (*pb.UpdateBookResponse, error) {
+	s.requests = append(s.requests, req)
+	return &pb.UpdateBookResponse{Book: req.Book}, nil
+}
+
+func (s *stubLibraryService) DeleteBook(_ context.Context, req *pb.DeleteBookRequest) (*pb.DeleteBookResponse, error) {
+	s.requests = append(s.requests, req)
+	return &pb.DeleteBookResponse{}, nil
+}
+
+func (s *stubLibraryService) BorrowBook(_ context.Context, req *pb.BorrowBookRequest) (*pb.BorrowBookResponse, error) {
+	s.requests = append(s.requests, req)
+	st, _ := status.New(codes.FailedPrecondition, "book is not available").
+		WithDetails(&errdetails.ErrorInfo{Reason: "BOOK_UNAVAILABLE", Domain: "library"})
+	return nil, st.Err()
+}
+
+// newTestGateway serves the gateway in front of an in-memory gRPC server
+func newTestGateway(t *testing.T, opts ...grpc.ServerOption) (http.Handler, *stubLibraryService) {
+	listener := bufconn.Listen(1 << 20)
+	stub := &stubLibraryService{}
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterLibraryServiceServer(grpcServer, stub)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	gateway, err := NewGateway(context.Background(), conn)
+	require.NoError(t, err)
+	return gateway, stub
+}
+
+// TestGateway_Routes tests that the existing REST paths reach their RPCs
+func TestGateway_Routes(t *testing.T) {
+	cases := map[string]struct {
+		method  string
+		path    string
+		body    string
+		status  int
+		request proto.Message
+	}{
+		"Own Profile":   {http.MethodGet, "/api/users/me", "", http.StatusOK, &pb.GetUserRequest{}},
+		"Other Profile": {http.MethodGet, "/api/users/user-id-2", "", http.StatusOK, &pb.GetUserRequest{UserId: "user-id-2"}},
+		"Register":      {http.MethodPost, "/api/users/registerUser", `{"name": "Ada", "email": "ada@example.com"}`, http.StatusCreated, &pb.RegisterUserRequest{Name: "Ada", Email: "ada@example.com"}},
+		"Search":        {http.MethodGet, "/api/books/search?query=dune&available_only=true", "", http.StatusOK, &pb.SearchBooksRequest{Query: "dune", AvailableOnly: true}},
+		"Create":        {http.MethodPost, "/api/books", `{"title": "Dune"}`, http.StatusCreated, &pb.CreateBookRequest{Book: &pb.Book{Title: "Dune"}}},
+		"Create Nested": {http.MethodPost, "/api/books", `{"book": {"title": "Dune"}}`, http.StatusCreated, &pb.CreateBookRequest{Book: &pb.Book{Title: "Dune"}}},
+		"Delete":        {http.MethodDelete, "/api/books/book-id-1?version=3", "", http.StatusNoContent, &pb.DeleteBookRequest{Id: "book-id-1", Version: 3}},
+	}
+
+	for name, tc := range cases {
+		gateway, stub := newTestGateway(t)
+
+		recorder := httptest.NewRecorder()
+		gateway.ServeHTTP(recorder, httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body)))
+
+		assert.Equal(t, tc.status, recorder.Code, name)
+		if assert.Len(t, stub.requests, 1, name) {
+			assert.True(t, proto.Equal(tc.request, stub.requests[0]), name)
+		}
+	}
+}
+
+// TestGateway_Encoding tests that responses use the proto field names and include zero values,
+// and that single resources are rendered unwrapped as before the gateway
+func TestGateway_Encoding(t *testing.T) {
+	gateway, _ := newTestGateway(t)
+
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/users/me", nil))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, "user-id-1", body["id"])
+	assert.Equal(t, "Ada", body["name"])
+	assert.Equal(t, false, body["email_verified"])
+	assert.NotContains(t, body, "user")
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+}
+
+// TestGateway_CreateBook tests that POST /api/books takes and returns the flat book, with its
+// copies next to the book's fields, as the REST API did before the gateway
+func TestGateway_CreateBook(t *testing.T) {
+	gateway, stub := newTestGateway(t)
+
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/books", strings.NewReader(
+		`{"title": "Dune", "author": "Frank Herbert", "copies": [{"barcode": "B-1", "branch": "Main"}]}`)))
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	require.Len(t, stub.requests, 1)
+	assert.True(t, proto.Equal(&pb.CreateBookRequest{
+		Book:   &pb.Book{Title: "Dune", Author: "Frank Herbert"},
+		Copies: []*pb.BookCopy{{Barcode: "B-1", Branch: "Main"}},
+	}, stub.requests[0]))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, "book-id-1", body["id"])
+	assert.Equal(t, "Dune", body["title"])
+	assert.Equal(t, float64(1965), body["publication_year"])
+	assert.NotContains(t, body, "book")
+	if assert.Len(t, body["copies"], 1) {
+		assert.Equal(t, "B-1", body["copies"].([]any)[0].(map[string]any)["barcode"])
+	}
+}
+
+// TestGateway_UpdateMask tests that a PATCH body names the fields to update
+func TestGateway_UpdateMask(t *testing.T) {
+	gateway, stub := newTestGateway(t)
+
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodPatch, "/api/books/book-id-1", strings.NewReader(`{"title": "Dune", "version": 3}`)))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	require.Len(t, stub.requests, 1)
+	request := stub.requests[0].(*pb.UpdateBookRequest)
+	assert.Equal(t, "book-id-1", request.Book.Id)
+	assert.Equal(t, int32(3), request.Book.Version)
+	assert.ElementsMatch(t, []string{"title", "version"}, request.UpdateMask.Paths)
+}
+
+// TestGateway_Errors tests that service and routing errors are rendered as problem details
+func TestGateway_Errors(t *testing.T) {
+	cases := map[string]struct {
+		method string
+		path   string
+		want   problem
+	}{
+		"Service Error": {http.MethodPost, "/api/books/book-id-1/borrowBook", problem{
+			Type: "about:blank", Title: "Conflict", Status: http.StatusConflict,
+			Detail: "book is not available", Instance: "/api/books/book-id-1/borrowBook", Reason: "BOOK_UNAVAILABLE",
+		}},
+		"Unknown Route": {http.MethodGet, "/api/shelves", problem{
+			Type: "about:blank", Title: "Not Found", Status: http.StatusNotFound, Instance: "/api/shelves",
+		}},
+		"Unimplemented": {http.MethodGet, "/api/holds", problem{
+			Type: "about:blank", Title: "Not Implemented", Status: http.StatusNotImplemented,
+			Detail: "method ListHolds not implemented", Instance: "/api/holds",
+		}},
+	}
+
+	for name, tc := range cases {
+		gateway, _ := newTestGateway(t)
+
+		recorder := httptest.NewRecorder()
+		gateway.ServeHTTP(recorder, httptest.NewRequest(tc.method, tc.path, strings.NewReader("{}")))
+
+		var body problem
+		assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body), name)
+		assert.Equal(t, tc.want, body, name)
+		assert.Equal(t, tc.want.Status, recorder.Code, name)
+		assert.Equal(t, problemContentType, recorder.Header().Get("Content-Type"), name)
+	}
+}
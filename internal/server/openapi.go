@@ -0,0 +1,380 @@
+package server
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// bearerAuth names the security scheme of access tokens in the OpenAPI document
+const bearerAuth = "bearerAuth"
+
+// openAPIDocument is the part of the OpenAPI 3 object model that describes the REST API
+type openAPIDocument struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       openAPIInfo                      `json:"info"`
+	Security   []map[string][]string            `json:"security"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components components                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type operation struct {
+	OperationID string                 `json:"operationId"`
+	Tags        []string               `json:"tags,omitempty"`
+	Parameters  []parameter            `json:"parameters,omitempty"`
+	RequestBody *requestBody           `json:"requestBody,omitempty"`
+	Responses   map[string]*response   `json:"responses"`
+	Security    *[]map[string][]string `json:"security,omitempty"` // Empty but present for public operations
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Required    bool    `json:"required,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Schema      *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]mediaType `json:"content"`
+}
+
+type response struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Content     map[string]mediaType `json:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+type components struct {
+	Schemas         map[string]*schema        `json:"schemas"`
+	Responses       map[string]*response      `json:"responses"`
+	SecuritySchemes map[string]securityScheme `json:"securitySchemes"`
+}
+
+type securityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// newOpenAPIDocument describes every REST route of the LibraryService. Like the gateway, it is
+// derived from the google.api.http annotations, and the message schemas follow the protojson
+// encoding the gateway uses.
+func newOpenAPIDocument() *openAPIDocument {
+	b := &openAPIBuilder{schemas: map[string]*schema{}}
+	doc := &openAPIDocument{
+		OpenAPI:  "3.0.3",
+		Info:     openAPIInfo{Title: "Library Management Service", Version: "v1"},
+		Security: []map[string][]string{{bearerAuth: {}}},
+		Paths:    map[string]map[string]*operation{},
+		Components: components{
+			Schemas: b.schemas,
+			Responses: map[string]*response{
+				"Problem": {
+					Description: "RFC 7807 problem details",
+					Content:     map[string]mediaType{problemContentType: {Schema: &schema{Ref: "#/components/schemas/Problem"}}},
+				},
+			},
+			SecuritySchemes: map[string]securityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+	b.schemas["Problem"] = problemSchema
+
+	methods := pb.File_proto_library_v1_library_proto.Services().ByName("LibraryService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+		for n, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			verb, template := httpRulePattern(binding)
+			path, op := b.operation(method, template, binding.GetBody(), binding.GetResponseBody())
+			if n > 0 {
+				op.OperationID += "_" + strconv.Itoa(n)
+			}
+			if doc.Paths[path] == nil {
+				doc.Paths[path] = map[string]*operation{}
+			}
+			doc.Paths[path][strings.ToLower(verb)] = op
+		}
+	}
+
+	// The gateway flattens the book of CreateBook, see wrapCreateBookBody and flattenCreatedBook
+	createBook := doc.Paths["/api/books"]["post"]
+	createBook.RequestBody.Content["application/json"] = mediaType{Schema: b.bookWithCopiesRef()}
+	createBook.Responses[strconv.Itoa(http.StatusCreated)].Content["application/json"] = mediaType{Schema: b.bookWithCopiesRef()}
+	return doc
+}
+
+// problemSchema is the schema of the problem details written by handleError
+var problemSchema = &schema{
+	Type:     "object",
+	Required: []string{"type", "title", "status"},
+	Properties: map[string]*schema{
+		"type":     {Type: "string", Description: "Always about:blank; the status identifies the problem"},
+		"title":    {Type: "string"},
+		"status":   {Type: "integer", Format: "int32"},
+		"detail":   {Type: "string", Description: "Omitted for internal errors"},
+		"instance": {Type: "string", Description: "The request path"},
+		"reason":   {Type: "string", Description: "Machine-readable reason of domain errors, e.g. BOOK_UNAVAILABLE"},
+	},
+}
+
+// httpRulePattern returns the HTTP method and path template of a binding
+func httpRulePattern(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		return http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		return http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		return http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	default:
+		return "", ""
+	}
+}
+
+// openAPIBuilder collects the schemas of the messages and enums the operations refer to
+type openAPIBuilder struct {
+	schemas map[string]*schema
+}
+
+// operation describes the binding of method to template and returns it with its OpenAPI path.
+// body and responseBody name the fields of the request and response sent as the HTTP bodies.
+func (b *openAPIBuilder) operation(method protoreflect.MethodDescriptor, template, body, responseBody string) (string, *operation) {
+	input := method.Input()
+	op := &operation{
+		OperationID: string(method.Name()),
+		Responses:   map[string]*response{"default": {Ref: "#/components/responses/Problem"}},
+	}
+
+	// Path variables name request fields; wildcards match any segment and are not used
+	segments := strings.Split(template, "/")
+	bound := map[string]bool{}
+	wildcards := 0
+	for i, segment := range segments {
+		switch {
+		case segment == "*":
+			wildcards++
+			name := "wildcard" + strconv.Itoa(wildcards)
+			segments[i] = "{" + name + "}"
+			op.Parameters = append(op.Parameters, parameter{
+				Name: name, In: "path", Required: true, Description: "Any value; not used", Schema: &schema{Type: "string"},
+			})
+		case strings.HasPrefix(segment, "{"):
+			name, _, _ := strings.Cut(strings.Trim(segment, "{}"), "=")
+			segments[i] = "{" + name + "}"
+			top, _, _ := strings.Cut(name, ".")
+			bound[top] = true
+			op.Parameters = append(op.Parameters, parameter{
+				Name: name, In: "path", Required: true, Schema: b.fieldSchema(fieldByPath(input, name)),
+			})
+		}
+	}
+	path := strings.Join(segments, "/")
+	if len(segments) > 2 && strings.HasPrefix(path, "/api/") {
+		op.Tags = []string{segments[2]}
+	}
+
+	fields := input.Fields()
+	switch body {
+	case "":
+	case "*":
+		bodySchema := b.messageRef(input)
+		if len(bound) > 0 {
+			// The path variables are left out of the body
+			bodySchema = &schema{Type: "object", Properties: map[string]*schema{}}
+			for i := 0; i < fields.Len(); i++ {
+				if field := fields.Get(i); !bound[string(field.Name())] {
+					bodySchema.Properties[string(field.Name())] = b.fieldSchema(field)
+				}
+			}
+		}
+		op.RequestBody = &requestBody{Required: true, Content: map[string]mediaType{"application/json": {Schema: bodySchema}}}
+	default:
+		bound[body] = true
+		op.RequestBody = &requestBody{Required: true, Content: map[string]mediaType{
+			"application/json": {Schema: b.fieldSchema(fields.ByName(protoreflect.Name(body)))},
+		}}
+	}
+
+	// The remaining fields are set from the query string, unless the whole request is the body
+	if body != "*" {
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			if bound[string(field.Name())] || (field.Message() != nil && !isFieldMask(field.Message())) {
+				continue
+			}
+			op.Parameters = append(op.Parameters, parameter{Name: string(field.Name()), In: "query", Schema: b.fieldSchema(field)})
+		}
+	}
+
+	code := http.StatusOK
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName()); err == nil {
+		code = successStatus(mt.Zero().Interface())
+	}
+	if code == http.StatusNoContent {
+		op.Responses[strconv.Itoa(code)] = &response{Description: http.StatusText(code)}
+	} else {
+		responseSchema := b.messageRef(method.Output())
+		if responseBody != "" {
+			responseSchema = b.fieldSchema(method.Output().Fields().ByName(protoreflect.Name(responseBody)))
+		}
+		op.Responses[strconv.Itoa(code)] = &response{
+			Description: http.StatusText(code),
+			Content:     map[string]mediaType{"application/json": {Schema: responseSchema}},
+		}
+	}
+
+	if slices.Contains(service.PublicMethods, "/"+string(method.Parent().FullName())+"/"+string(method.Name())) {
+		op.Security = &[]map[string][]string{}
+	}
+	return path, op
+}
+
+// fieldByPath resolves a dotted path of field names in message
+func fieldByPath(message protoreflect.MessageDescriptor, path string) protoreflect.FieldDescriptor {
+	var field protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		field = message.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil
+		}
+		message = field.Message()
+	}
+	return field
+}
+
+// fieldSchema returns the schema of the protojson encoding of field
+func (b *openAPIBuilder) fieldSchema(field protoreflect.FieldDescriptor) *schema {
+	switch {
+	case field == nil:
+		return &schema{Type: "string"}
+	case field.IsMap():
+		return &schema{Type: "object", AdditionalProperties: b.valueSchema(field.MapValue())}
+	case field.IsList():
+		return &schema{Type: "array", Items: b.valueSchema(field)}
+	default:
+		return b.valueSchema(field)
+	}
+}
+
+// valueSchema returns the schema of a single value of field
+func (b *openAPIBuilder) valueSchema(field protoreflect.FieldDescriptor) *schema {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return &schema{Type: "boolean"}
+	case protoreflect.StringKind:
+		return &schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &schema{Type: "string", Format: "byte"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &schema{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson encodes 64-bit integers as strings
+		return &schema{Type: "string", Format: "int64"}
+	case protoreflect.FloatKind:
+		return &schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &schema{Type: "number", Format: "double"}
+	case protoreflect.EnumKind:
+		return b.enumRef(field.Enum())
+	default:
+		return b.messageRef(field.Message())
+	}
+}
+
+// messageRef returns a reference to the schema of message, adding it to the components
+func (b *openAPIBuilder) messageRef(message protoreflect.MessageDescriptor) *schema {
+	if isFieldMask(message) {
+		return &schema{Type: "string", Description: "Comma-separated field names"}
+	}
+
+	name := string(message.Name())
+	if _, ok := b.schemas[name]; !ok {
+		s := &schema{Type: "object", Properties: map[string]*schema{}}
+		// Added before its fields, so that recursive messages end
+		b.schemas[name] = s
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			s.Properties[string(fields.Get(i).Name())] = b.fieldSchema(fields.Get(i))
+		}
+	}
+	return &schema{Ref: "#/components/schemas/" + name}
+}
+
+// bookWithCopiesRef returns a reference to the schema of a book with its copies added, as in the
+// bodies of CreateBook
+func (b *openAPIBuilder) bookWithCopiesRef() *schema {
+	const name = "BookWithCopies"
+	if _, ok := b.schemas[name]; !ok {
+		messages := pb.File_proto_library_v1_library_proto.Messages()
+		book := messages.ByName("Book")
+		b.messageRef(book)
+		s := &schema{Type: "object", Properties: maps.Clone(b.schemas[string(book.Name())].Properties)}
+		s.Properties["copies"] = b.fieldSchema(messages.ByName("CreateBookRequest").Fields().ByName("copies"))
+		b.schemas[name] = s
+	}
+	return &schema{Ref: "#/components/schemas/" + name}
+}
+
+// enumRef returns a reference to the schema of enum, adding it to the components
+func (b *openAPIBuilder) enumRef(enum protoreflect.EnumDescriptor) *schema {
+	name := string(enum.Name())
+	if _, ok := b.schemas[name]; !ok {
+		s := &schema{Type: "string"}
+		values := enum.Values()
+		for i := 0; i < values.Len(); i++ {
+			s.Enum = append(s.Enum, string(values.Get(i).Name()))
+		}
+		b.schemas[name] = s
+	}
+	return &schema{Ref: "#/components/schemas/" + name}
+}
+
+func isFieldMask(message protoreflect.MessageDescriptor) bool {
+	return message.FullName() == "google.protobuf.FieldMask"
+}
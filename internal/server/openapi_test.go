@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gatewayPattern matches the names of the route patterns in the generated gateway
+var gatewayPattern = regexp.MustCompile(`^pattern_LibraryService_(\w+)_(\d+)$`)
+
+// TestOpenAPI_DocumentsEveryRoute tests that every route the generated gateway registers is
+// described, with the same HTTP method, in the OpenAPI document
+func TestOpenAPI_DocumentsEveryRoute(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../../proto/library/v1/library.pb.gw.go", nil, 0)
+	require.NoError(t, err)
+
+	registered := map[string]string{}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "RegisterLibraryServiceHandlerClient" {
+			continue
+		}
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "Handle" {
+				return true
+			}
+			method, _ := call.Args[0].(*ast.SelectorExpr)
+			pattern, _ := call.Args[1].(*ast.Ident)
+			if method == nil || pattern == nil {
+				return true
+			}
+			match := gatewayPattern.FindStringSubmatch(pattern.Name)
+			require.NotNil(t, match, pattern.Name)
+			operationID := match[1]
+			if match[2] != "0" {
+				operationID += "_" + match[2]
+			}
+			registered[operationID] = strings.ToUpper(strings.TrimPrefix(method.Sel.Name, "Method"))
+			return true
+		})
+	}
+	require.NotEmpty(t, registered)
+
+	documented := map[string]string{}
+	for _, operations := range newOpenAPIDocument().Paths {
+		for verb, op := range operations {
+			documented[op.OperationID] = strings.ToUpper(verb)
+		}
+	}
+
+	assert.Equal(t, registered, documented)
+}
+
+// TestOpenAPI_Bodies tests that the documented bodies are the ones the gateway reads and writes
+func TestOpenAPI_Bodies(t *testing.T) {
+	doc := newOpenAPIDocument()
+
+	cases := map[string]struct {
+		op       *operation
+		code     string
+		request  string
+		response string
+	}{
+		"Get Book":      {doc.Paths["/api/books/{id}"]["get"], "200", "", "#/components/schemas/Book"},
+		"Own Profile":   {doc.Paths["/api/users/me"]["get"], "200", "", "#/components/schemas/User"},
+		"List Books":    {doc.Paths["/api/books"]["get"], "200", "", "#/components/schemas/ListBooksResponse"},
+		"Create Book":   {doc.Paths["/api/books"]["post"], "201", "#/components/schemas/BookWithCopies", "#/components/schemas/BookWithCopies"},
+		"Register User": {doc.Paths["/api/users/registerUser"]["post"], "201", "#/components/schemas/RegisterUserRequest", "#/components/schemas/User"},
+	}
+
+	for name, tc := range cases {
+		require.NotNil(t, tc.op, name)
+		if tc.request != "" {
+			assert.Equal(t, tc.request, tc.op.RequestBody.Content["application/json"].Schema.Ref, name)
+		}
+		assert.Equal(t, tc.response, tc.op.Responses[tc.code].Content["application/json"].Schema.Ref, name)
+	}
+	assert.Contains(t, doc.Components.Schemas["BookWithCopies"].Properties, "title")
+	assert.Contains(t, doc.Components.Schemas["BookWithCopies"].Properties, "copies")
+}
+
+// TestGateway_DocumentedRoutes tests that every documented route reaches the RPC it documents,
+// so that no route is shadowed by another one
+func TestGateway_DocumentedRoutes(t *testing.T) {
+	var reached string
+	gateway, _ := newTestGateway(t, grpc.UnaryInterceptor(
+		func(_ context.Context, _ any, info *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (any, error) {
+			reached = info.FullMethod
+			return nil, status.Error(codes.Unimplemented, "not called")
+		},
+	))
+
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var doc openAPIDocument
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &doc))
+	require.NotEmpty(t, doc.Paths)
+
+	for path, operations := range doc.Paths {
+		for verb, op := range operations {
+			// Path variables are filled with a value of their type
+			target := path
+			for _, param := range op.Parameters {
+				value := "sample"
+				if param.Schema.Ref != "" {
+					enum := doc.Components.Schemas[strings.TrimPrefix(param.Schema.Ref, "#/components/schemas/")].Enum
+					value = enum[len(enum)-1]
+				}
+				target = strings.Replace(target, "{"+param.Name+"}", value, 1)
+			}
+
+			reached = ""
+			recorder := httptest.NewRecorder()
+			gateway.ServeHTTP(recorder, httptest.NewRequest(strings.ToUpper(verb), target, strings.NewReader("{}")))
+
+			method, _, _ := strings.Cut(op.OperationID, "_")
+			assert.Equal(t, "/pb.LibraryService/"+method, reached, "%s %s", strings.ToUpper(verb), target)
+		}
+	}
+}
+
+// TestGateway_SwaggerUI tests that the Swagger UI is served and loads the OpenAPI document
+func TestGateway_SwaggerUI(t *testing.T) {
+	gateway, _ := newTestGateway(t)
+
+	cases := map[string]struct {
+		path     string
+		contains string
+	}{
+		"Page":        {"/docs/", `<div id="swagger-ui">`},
+		"Bundle":      {"/docs/swagger-ui-bundle.js", "SwaggerUIBundle"},
+		"Initializer": {"/docs/swagger-initializer.js", `url: "/openapi.json"`},
+	}
+
+	for name, tc := range cases {
+		recorder := httptest.NewRecorder()
+		gateway.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code, name)
+		assert.Contains(t, recorder.Body.String(), tc.contains, name)
+	}
+}
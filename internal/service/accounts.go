@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Patron account methods
+func (s *LibraryService) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Patrons see their own account; staff see anyone's
+	if req.UserId == "" {
+		req.UserId = principal.UserID
+	} else if req.UserId != principal.UserID && !isStaff(principal) {
+		return nil, status.Error(codes.PermissionDenied, "cannot view the account of another user")
+	}
+
+	balance, err := s.userRepo.GetBalance(ctx, req.UserId)
+	if err != nil {
+		return nil, errorStatus(err, "failed to get balance")
+	}
+
+	entries, err := s.userRepo.ListLedgerEntries(ctx, req.UserId)
+	if err != nil {
+		return nil, errorStatus(err, "failed to list ledger entries")
+	}
+
+	return &pb.GetAccountResponse{
+		UserId:       req.UserId,
+		BalanceCents: balance,
+		Entries:      entries,
+	}, nil
+}
+
+func (s *LibraryService) RecordPayment(ctx context.Context, req *pb.RecordPaymentRequest) (*pb.RecordPaymentResponse, error) {
+	principal, err := authorize(ctx, staffRoles...)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if req.AmountCents <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	entry, balance, err := s.userRepo.RecordPayment(ctx, req.UserId, req.AmountCents, req.Note, principal.UserID)
+	if err != nil {
+		return nil, errorStatus(err, "failed to record payment")
+	}
+
+	return &pb.RecordPaymentResponse{Entry: entry, BalanceCents: balance}, nil
+}
+
+func (s *LibraryService) WaiveFine(ctx context.Context, req *pb.WaiveFineRequest) (*pb.WaiveFineResponse, error) {
+	principal, err := authorize(ctx, staffRoles...)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.EntryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "entry id is required")
+	}
+
+	entry, balance, err := s.userRepo.WaiveFine(ctx, req.EntryId, req.Note, principal.UserID)
+	if err != nil {
+		return nil, errorStatus(err, "failed to waive fine")
+	}
+
+	return &pb.WaiveFineResponse{Entry: entry, BalanceCents: balance}, nil
+}
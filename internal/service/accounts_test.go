@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test the patron account methods with mocks
+func TestLibraryService_Accounts(t *testing.T) {
+	patron := &auth.Principal{UserID: "patron-id", Roles: []string{auth.RolePatron}}
+	librarian := &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}}
+
+	t.Run("Get Account Defaults To Caller", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+		charge := &pb.LedgerEntry{Id: "charge-id-1", Kind: pb.LedgerEntryKind_LEDGER_ENTRY_KIND_CHARGE, AmountCents: 75}
+
+		// Set up mock expectations
+		mockUserRepo.On("GetBalance", ctx, "patron-id").Return(int64(75), nil)
+		mockUserRepo.On("ListLedgerEntries", ctx, "patron-id").Return([]*pb.LedgerEntry{charge}, nil)
+
+		// Execute
+		response, err := svc.GetAccount(ctx, &pb.GetAccountRequest{})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "patron-id", response.UserId)
+		assert.Equal(t, int64(75), response.BalanceCents)
+		assert.Len(t, response.Entries, 1)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Get Another Patron's Account Denied", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Execute
+		response, err := svc.GetAccount(ctx, &pb.GetAccountRequest{UserId: "someone-else"})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockUserRepo.AssertNotCalled(t, "GetBalance", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Record Payment", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+		payment := &pb.LedgerEntry{Id: "payment-id-1", Kind: pb.LedgerEntryKind_LEDGER_ENTRY_KIND_PAYMENT, AmountCents: 50}
+
+		// Set up mock expectation; the payment is recorded by the librarian
+		mockUserRepo.On("RecordPayment", ctx, "patron-id", int64(50), "cash", "librarian-id").Return(payment, int64(25), nil)
+
+		// Execute
+		response, err := svc.RecordPayment(ctx, &pb.RecordPaymentRequest{UserId: "patron-id", AmountCents: 50, Note: "cash"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "payment-id-1", response.Entry.Id)
+		assert.Equal(t, int64(25), response.BalanceCents)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Record Payment Requires Staff", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Execute
+		response, err := svc.RecordPayment(ctx, &pb.RecordPaymentRequest{UserId: "patron-id", AmountCents: 50})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Invalid Payments", func(t *testing.T) {
+		cases := map[string]struct {
+			amount  int64
+			repoErr error
+			code    codes.Code
+		}{
+			"Zero Amount": {0, nil, codes.InvalidArgument},
+			"Overpayment": {500, repository.ErrOverpayment, codes.FailedPrecondition},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Test data
+			ctx := auth.NewContext(context.Background(), librarian)
+
+			// Set up mock expectation
+			mockUserRepo.On("RecordPayment", ctx, "patron-id", tc.amount, "", "librarian-id").Return(nil, int64(0), tc.repoErr)
+
+			// Execute
+			response, err := svc.RecordPayment(ctx, &pb.RecordPaymentRequest{UserId: "patron-id", AmountCents: tc.amount})
+
+			// Verify
+			assert.Nil(t, response)
+			assert.Equal(t, tc.code, status.Code(err), name)
+		}
+	})
+
+	t.Run("Waive Fine", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+		waiver := &pb.LedgerEntry{Id: "waiver-id-1", Kind: pb.LedgerEntryKind_LEDGER_ENTRY_KIND_WAIVER, WaivedEntryId: "charge-id-1"}
+
+		// Set up mock expectation
+		mockUserRepo.On("WaiveFine", ctx, "charge-id-1", "", "librarian-id").Return(waiver, int64(0), nil)
+
+		// Execute
+		response, err := svc.WaiveFine(ctx, &pb.WaiveFineRequest{EntryId: "charge-id-1"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "charge-id-1", response.Entry.WaivedEntryId)
+		assert.Equal(t, int64(0), response.BalanceCents)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Waive Fine Errors", func(t *testing.T) {
+		cases := map[error]codes.Code{
+			repository.ErrNotAFine:    codes.InvalidArgument,
+			repository.ErrFineSettled: codes.FailedPrecondition,
+		}
+
+		for repoErr, code := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Test data
+			ctx := auth.NewContext(context.Background(), librarian)
+
+			// Set up mock expectation
+			mockUserRepo.On("WaiveFine", ctx, "entry-id-1", "", "librarian-id").Return(nil, int64(0), repoErr)
+
+			// Execute
+			response, err := svc.WaiveFine(ctx, &pb.WaiveFineRequest{EntryId: "entry-id-1"})
+
+			// Verify
+			assert.Nil(t, response)
+			assert.Equal(t, code, status.Code(err), repoErr.Error())
+		}
+	})
+}
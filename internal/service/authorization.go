@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/auth"
+)
+
+// staffRoles may act on behalf of other users
+var staffRoles = []string{auth.RoleLibrarian, auth.RoleAdmin}
+
+// authorize returns the authenticated caller, failing unless they hold one of roles.
+// Any authenticated caller is accepted when no roles are given.
+func authorize(ctx context.Context, roles ...string) (*auth.Principal, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+	if len(roles) > 0 && !principal.HasRole(roles...) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return principal, nil
+}
+
+// isStaff reports whether the principal may act on behalf of other users
+func isStaff(principal *auth.Principal) bool {
+	return principal.HasRole(staffRoles...)
+}
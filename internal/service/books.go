@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
+)
+
+// languagePattern matches ISO 639-1 and 639-2 language codes
+var languagePattern = regexp.MustCompile(`^[a-z]{2,3}$`)
+
+// updatableBookFields are the Book fields UpdateBook can change
+var updatableBookFields = []string{
+	"title", "author", "isbn", "description", "subjects", "publication_year", "language",
+	"publisher", "edition", "page_count", "cover_url", "contributors",
+}
+
+// Maximum lengths of the bibliographic fields stored in bounded columns
+const (
+	maxTitleLength     = 255
+	maxNameLength      = 255
+	maxPublisherLength = 255
+	maxEditionLength   = 100
+)
+
+// Catalogue maintenance methods
+func (s *LibraryService) UpdateBook(ctx context.Context, req *pb.UpdateBookRequest) (*pb.UpdateBookResponse, error) {
+	if _, err := authorize(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+
+	if req.Book == nil || req.Book.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "book with id is required")
+	}
+
+	paths := updatableBookFields
+	if len(req.GetUpdateMask().GetPaths()) > 0 {
+		// The version is a precondition rather than a change. The REST gateway derives the mask
+		// from the fields of a PATCH body, so it names the version whenever one is sent.
+		paths = slices.DeleteFunc(slices.Clone(req.UpdateMask.GetPaths()), func(path string) bool { return path == "version" })
+		if len(paths) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "no fields to update")
+		}
+		for _, path := range paths {
+			if !slices.Contains(updatableBookFields, path) {
+				return nil, status.Errorf(codes.InvalidArgument, "field %q cannot be updated", path)
+			}
+		}
+	}
+
+	current, err := s.bookRepo.GetByID(ctx, req.Book.Id)
+	if err != nil {
+		return nil, errorStatus(err, "failed to get book")
+	}
+	if req.Book.Version != 0 && req.Book.Version != current.Version {
+		return nil, status.Errorf(codes.Aborted, "%v; fetch version %d and retry", repository.ErrVersionConflict, current.Version)
+	}
+
+	// Apply the masked fields to the stored book so that the result is validated as a whole.
+	// The repository refuses the update if the book changes after it was read.
+	updated := proto.Clone(current).(*pb.Book)
+	source, target := req.Book.ProtoReflect(), updated.ProtoReflect()
+	for _, path := range paths {
+		field := target.Descriptor().Fields().ByName(protoreflect.Name(path))
+		if source.Has(field) {
+			target.Set(field, source.Get(field))
+		} else {
+			target.Clear(field)
+		}
+	}
+	if err := validateBook(updated); err != nil {
+		return nil, err
+	}
+
+	book, err := s.bookRepo.Update(ctx, updated, slices.Clone(paths))
+	if err != nil {
+		return nil, errorStatus(err, "failed to update book")
+	}
+
+	return &pb.UpdateBookResponse{Book: book}, nil
+}
+
+func (s *LibraryService) DeleteBook(ctx context.Context, req *pb.DeleteBookRequest) (*pb.DeleteBookResponse, error) {
+	if _, err := authorize(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "book id is required")
+	}
+
+	if err := s.bookRepo.Delete(ctx, req.Id, req.Version); err != nil {
+		return nil, errorStatus(err, "failed to delete book")
+	}
+
+	return &pb.DeleteBookResponse{}, nil
+}
+
+// validateBook checks the bibliographic fields of a book before it is stored. Language codes are
+// lower-cased and surrounding whitespace is trimmed.
+func validateBook(book *pb.Book) error {
+	book.Title = strings.TrimSpace(book.Title)
+	book.Author = strings.TrimSpace(book.Author)
+	book.Language = strings.ToLower(strings.TrimSpace(book.Language))
+
+	if book.Title == "" {
+		return status.Error(codes.InvalidArgument, "title is required")
+	}
+	if len(book.Title) > maxTitleLength {
+		return status.Errorf(codes.InvalidArgument, "title must be at most %d characters", maxTitleLength)
+	}
+	if len(book.Author) > maxNameLength {
+		return status.Errorf(codes.InvalidArgument, "author must be at most %d characters", maxNameLength)
+	}
+	if len(book.Publisher) > maxPublisherLength {
+		return status.Errorf(codes.InvalidArgument, "publisher must be at most %d characters", maxPublisherLength)
+	}
+	if len(book.Edition) > maxEditionLength {
+		return status.Errorf(codes.InvalidArgument, "edition must be at most %d characters", maxEditionLength)
+	}
+
+	if err := validateContributors(book); err != nil {
+		return err
+	}
+
+	// Forthcoming titles may be catalogued a year ahead
+	if book.PublicationYear < 0 || book.PublicationYear > int32(time.Now().Year()+1) {
+		return status.Errorf(codes.InvalidArgument, "invalid publication year %d", book.PublicationYear)
+	}
+	if book.PageCount < 0 {
+		return status.Error(codes.InvalidArgument, "page count cannot be negative")
+	}
+	if book.Language != "" && !languagePattern.MatchString(book.Language) {
+		return status.Errorf(codes.InvalidArgument, "language %q is not an ISO 639 code", book.Language)
+	}
+	for _, subject := range book.Subjects {
+		if strings.TrimSpace(subject) == "" {
+			return status.Error(codes.InvalidArgument, "subjects cannot be blank")
+		}
+	}
+	if book.CoverUrl != "" {
+		cover, err := url.Parse(book.CoverUrl)
+		if err != nil || (cover.Scheme != "http" && cover.Scheme != "https") || cover.Host == "" {
+			return status.Error(codes.InvalidArgument, "cover url must be an absolute http or https url")
+		}
+	}
+
+	return nil
+}
+
+// validateContributors checks that the book is credited to at least one author, either through
+// its author field or its contributors, and that each contributor is credited once per role
+func validateContributors(book *pb.Book) error {
+	hasAuthor := book.Author != ""
+	credited := make(map[string]bool, len(book.Contributors))
+	for _, contributor := range book.Contributors {
+		contributor.Name = strings.TrimSpace(contributor.Name)
+		if contributor.Name == "" {
+			return status.Error(codes.InvalidArgument, "contributor name is required")
+		}
+		if len(contributor.Name) > maxNameLength {
+			return status.Errorf(codes.InvalidArgument, "contributor name must be at most %d characters", maxNameLength)
+		}
+		if _, ok := pb.ContributorRole_name[int32(contributor.Role)]; !ok || contributor.Role == pb.ContributorRole_CONTRIBUTOR_ROLE_UNSPECIFIED {
+			return status.Errorf(codes.InvalidArgument, "contributor %q needs a role", contributor.Name)
+		}
+
+		key := fmt.Sprintf("%s/%s", contributor.Name, contributor.Role)
+		if credited[key] {
+			return status.Errorf(codes.InvalidArgument, "contributor %q is credited twice as %s", contributor.Name, contributor.Role)
+		}
+		credited[key] = true
+		hasAuthor = hasAuthor || contributor.Role == pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR
+	}
+
+	if !hasAuthor {
+		return status.Error(codes.InvalidArgument, "an author is required")
+	}
+	return nil
+}
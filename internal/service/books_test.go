@@ -0,0 +1,229 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test CreateBook validation of the bibliographic fields
+func TestLibraryService_CreateBook_Validation(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}})
+	author := pb.ContributorRole_CONTRIBUTOR_ROLE_AUTHOR
+	translator := pb.ContributorRole_CONTRIBUTOR_ROLE_TRANSLATOR
+
+	t.Run("Contributors Without Author", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data: the author is credited through the contributors only
+		book := &pb.Book{
+			Title:     "The Dispossessed",
+			Language:  " EN ",
+			Publisher: "Harper & Row",
+			PageCount: 341,
+			CoverUrl:  "https://covers.example.com/dispossessed.jpg",
+			Contributors: []*pb.Contributor{
+				{Name: "Ursula K. Le Guin", Role: author},
+				{Name: "Someone Else", Role: translator},
+			},
+		}
+
+		// Set up mock expectation
+		mockBookRepo.On("Create", ctx, book, []*pb.BookCopy(nil)).Return(book, []*pb.BookCopy{{Id: "copy-id-1"}}, nil)
+
+		// Execute
+		response, err := svc.CreateBook(ctx, &pb.CreateBookRequest{Book: book})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "en", response.Book.Language)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Books", func(t *testing.T) {
+		cases := map[string]*pb.Book{
+			"Missing Title":       {Author: "Frank Herbert"},
+			"Missing Author":      {Title: "Dune"},
+			"Translator Only":     {Title: "Dune", Contributors: []*pb.Contributor{{Name: "Someone", Role: translator}}},
+			"Contributor Role":    {Title: "Dune", Contributors: []*pb.Contributor{{Name: "Frank Herbert"}}},
+			"Contributor Name":    {Title: "Dune", Author: "Frank Herbert", Contributors: []*pb.Contributor{{Role: author}}},
+			"Credited Twice":      {Title: "Dune", Contributors: []*pb.Contributor{{Name: "Frank Herbert", Role: author}, {Name: "Frank Herbert", Role: author}}},
+			"Future Year":         {Title: "Dune", Author: "Frank Herbert", PublicationYear: 9999},
+			"Negative Page Count": {Title: "Dune", Author: "Frank Herbert", PageCount: -1},
+			"Language Name":       {Title: "Dune", Author: "Frank Herbert", Language: "English"},
+			"Blank Subject":       {Title: "Dune", Author: "Frank Herbert", Subjects: []string{"Science fiction", " "}},
+			"Relative Cover Url":  {Title: "Dune", Author: "Frank Herbert", CoverUrl: "/covers/dune.jpg"},
+			"Cover Url Not Http":  {Title: "Dune", Author: "Frank Herbert", CoverUrl: "javascript:alert(1)"},
+		}
+
+		for name, book := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Execute
+			response, err := svc.CreateBook(ctx, &pb.CreateBookRequest{Book: book})
+
+			// Verify
+			assert.Nil(t, response, name)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+			mockBookRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+}
+
+// Test UpdateBook and DeleteBook with mocks
+func TestLibraryService_UpdateAndDeleteBook(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}})
+	stored := &pb.Book{Id: "book-id-1", Title: "Dnue", Author: "Frank Herbert", PageCount: 412, Version: 3}
+
+	t.Run("Update Masked Fields", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectations: the title is fixed, the page count is left alone and the
+		// update is conditional on the version that was read
+		mockBookRepo.On("GetByID", ctx, "book-id-1").Return(stored, nil)
+		mockBookRepo.On("Update", ctx, mock.MatchedBy(func(book *pb.Book) bool {
+			return book.Title == "Dune" && book.PageCount == 412 && book.Version == 3
+		}), []string{"title"}).Return(&pb.Book{Id: "book-id-1", Title: "Dune", Version: 4}, nil)
+
+		// Execute: the version in the mask is a precondition, as sent by the REST gateway
+		response, err := svc.UpdateBook(ctx, &pb.UpdateBookRequest{
+			Book:       &pb.Book{Id: "book-id-1", Title: "Dune", PageCount: 1, Version: 3},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "version"}},
+		})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), response.Book.Version)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update Errors", func(t *testing.T) {
+		cases := map[string]struct {
+			request *pb.UpdateBookRequest
+			repoErr error
+			code    codes.Code
+		}{
+			"Output Only Field": {
+				&pb.UpdateBookRequest{Book: &pb.Book{Id: "book-id-1"}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"total_copies"}}},
+				nil, codes.InvalidArgument,
+			},
+			"Only Version": {
+				&pb.UpdateBookRequest{Book: &pb.Book{Id: "book-id-1", Version: 3}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"version"}}},
+				nil, codes.InvalidArgument,
+			},
+			"Invalid Result": {
+				&pb.UpdateBookRequest{Book: &pb.Book{Id: "book-id-1"}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}},
+				nil, codes.InvalidArgument,
+			},
+			"Stale Version": {
+				&pb.UpdateBookRequest{Book: &pb.Book{Id: "book-id-1", Title: "Dune", Version: 2}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}},
+				nil, codes.Aborted,
+			},
+			"Concurrent Update": {
+				&pb.UpdateBookRequest{Book: &pb.Book{Id: "book-id-1", Title: "Dune"}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}},
+				repository.ErrVersionConflict, codes.Aborted,
+			},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectations
+			mockBookRepo.On("GetByID", ctx, "book-id-1").Return(stored, nil)
+			mockBookRepo.On("Update", ctx, mock.Anything, mock.Anything).Return(nil, tc.repoErr)
+
+			// Execute
+			response, err := svc.UpdateBook(ctx, tc.request)
+
+			// Verify
+			assert.Nil(t, response, name)
+			assert.Equal(t, tc.code, status.Code(err), name)
+		}
+	})
+
+	t.Run("Update Requires Staff", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		patronCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "patron-id", Roles: []string{auth.RolePatron}})
+
+		// Execute
+		response, err := svc.UpdateBook(patronCtx, &pb.UpdateBookRequest{Book: &pb.Book{Id: "book-id-1", Title: "Dune"}})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockBookRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		cases := map[string]struct {
+			repoErr error
+			code    codes.Code
+		}{
+			"Deleted":        {nil, codes.OK},
+			"Not Found":      {repository.ErrBookNotFound, codes.NotFound},
+			"Stale Version":  {repository.ErrVersionConflict, codes.Aborted},
+			"Copies On Loan": {repository.ErrBookOnLoan, codes.FailedPrecondition},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectation
+			mockBookRepo.On("Delete", ctx, "book-id-1", int32(3)).Return(tc.repoErr)
+
+			// Execute
+			_, err := svc.DeleteBook(ctx, &pb.DeleteBookRequest{Id: "book-id-1", Version: 3})
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(err), name)
+			mockBookRepo.AssertExpectations(t)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Copy (physical item) methods
+func (s *LibraryService) AddBookCopy(ctx context.Context, req *pb.AddBookCopyRequest) (*pb.AddBookCopyResponse, error) {
+	if _, err := authorize(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+
+	if req.Copy == nil || req.Copy.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "copy with book id is required")
+	}
+	if err := validateNewCopy(req.Copy); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.bookRepo.GetByID(ctx, req.Copy.BookId); err != nil {
+		return nil, errorStatus(err, "failed to get book")
+	}
+
+	bookCopy, err := s.bookRepo.AddCopy(ctx, req.Copy, s.holdExpiry())
+	if err != nil {
+		return nil, errorStatus(err, "failed to add copy")
+	}
+
+	return &pb.AddBookCopyResponse{Copy: bookCopy}, nil
+}
+
+func (s *LibraryService) ListBookCopies(ctx context.Context, req *pb.ListBookCopiesRequest) (*pb.ListBookCopiesResponse, error) {
+	if req.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book id is required")
+	}
+
+	copies, err := s.bookRepo.ListCopies(ctx, req.BookId)
+	if err != nil {
+		return nil, errorStatus(err, "failed to list copies")
+	}
+
+	return &pb.ListBookCopiesResponse{Copies: copies}, nil
+}
+
+func (s *LibraryService) UpdateBookCopy(ctx context.Context, req *pb.UpdateBookCopyRequest) (*pb.UpdateBookCopyResponse, error) {
+	if _, err := authorize(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "copy id is required")
+	}
+
+	// Loans and holds move copies in and out of these states, and deleting a book withdraws its copies
+	switch req.Status {
+	case pb.CopyStatus_COPY_STATUS_ON_LOAN, pb.CopyStatus_COPY_STATUS_ON_HOLD:
+		return nil, status.Error(codes.InvalidArgument, "copies are put on loan or on hold by borrowing and holds")
+	case pb.CopyStatus_COPY_STATUS_WITHDRAWN:
+		return nil, status.Error(codes.InvalidArgument, "copies are withdrawn by deleting their book")
+	}
+
+	bookCopy, err := s.bookRepo.UpdateCopy(ctx, &pb.BookCopy{
+		Id:        req.Id,
+		Branch:    req.Branch,
+		Condition: req.Condition,
+		Status:    req.Status,
+		ItemType:  req.ItemType,
+	}, s.holdExpiry())
+	if err != nil {
+		return nil, errorStatus(err, "failed to update copy")
+	}
+
+	return &pb.UpdateBookCopyResponse{Copy: bookCopy}, nil
+}
+
+// validateNewCopy checks the initial state of a copy being added to the catalog
+func validateNewCopy(bookCopy *pb.BookCopy) error {
+	switch bookCopy.Status {
+	case pb.CopyStatus_COPY_STATUS_UNSPECIFIED, pb.CopyStatus_COPY_STATUS_AVAILABLE, pb.CopyStatus_COPY_STATUS_MAINTENANCE:
+		return nil
+	default:
+		return status.Error(codes.InvalidArgument, "new copies must be available or in maintenance")
+	}
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test the copy management methods with mocks
+func TestLibraryService_BookCopies(t *testing.T) {
+	librarian := &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}}
+
+	t.Run("Add Copy", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+		bookCopy := &pb.BookCopy{BookId: "book-id-123", Branch: "east"}
+
+		// Set up mock expectations
+		mockBookRepo.On("GetByID", ctx, "book-id-123").Return(&pb.Book{Id: "book-id-123"}, nil)
+		mockBookRepo.On("AddCopy", ctx, bookCopy, mock.AnythingOfType("time.Time")).Return(&pb.BookCopy{Id: "copy-id-1", BookId: "book-id-123", Branch: "east"}, nil)
+
+		// Execute
+		response, err := svc.AddBookCopy(ctx, &pb.AddBookCopyRequest{Copy: bookCopy})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "copy-id-1", response.Copy.Id)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Add Copy Patron Denied", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "patron-id", Roles: []string{auth.RolePatron}})
+
+		// Execute
+		response, err := svc.AddBookCopy(ctx, &pb.AddBookCopyRequest{Copy: &pb.BookCopy{BookId: "book-id-123"}})
+
+		// Verify
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// The repository must not be touched
+		mockBookRepo.AssertNotCalled(t, "AddCopy", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Add Copy On Loan Rejected", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+		bookCopy := &pb.BookCopy{BookId: "book-id-123", Status: pb.CopyStatus_COPY_STATUS_ON_LOAN}
+
+		// Execute
+		response, err := svc.AddBookCopy(ctx, &pb.AddBookCopyRequest{Copy: bookCopy})
+
+		// Verify
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockBookRepo.AssertNotCalled(t, "AddCopy", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Update Copy", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+		update := &pb.BookCopy{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_MAINTENANCE}
+
+		// Set up mock expectation
+		mockBookRepo.On("UpdateCopy", ctx, update, mock.AnythingOfType("time.Time")).Return(&pb.BookCopy{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_MAINTENANCE}, nil)
+
+		// Execute
+		response, err := svc.UpdateBookCopy(ctx, &pb.UpdateBookCopyRequest{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_MAINTENANCE})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, pb.CopyStatus_COPY_STATUS_MAINTENANCE, response.Copy.Status)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update Copy To Available Offers Holds", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service with a one day pickup window
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithHoldPickupWindow(24*time.Hour))
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+		update := &pb.BookCopy{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_AVAILABLE}
+		var capturedExpiry time.Time
+
+		// Set up mock expectation - a waiting hold gets the copy
+		mockBookRepo.On("UpdateCopy", ctx, update, mock.AnythingOfType("time.Time")).
+			Run(func(args mock.Arguments) {
+				capturedExpiry = args.Get(2).(time.Time)
+			}).
+			Return(&pb.BookCopy{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_ON_HOLD}, nil)
+
+		// Execute
+		response, err := svc.UpdateBookCopy(ctx, &pb.UpdateBookCopyRequest{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_AVAILABLE})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, pb.CopyStatus_COPY_STATUS_ON_HOLD, response.Copy.Status)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), capturedExpiry, time.Minute)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update Copy To On Loan Rejected", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+
+		// Execute
+		response, err := svc.UpdateBookCopy(ctx, &pb.UpdateBookCopyRequest{Id: "copy-id-1", Status: pb.CopyStatus_COPY_STATUS_ON_LOAN})
+
+		// Verify
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockBookRepo.AssertNotCalled(t, "UpdateCopy", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
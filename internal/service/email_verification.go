@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/mail"
+	pb "library-management-service/proto/library/v1"
+)
+
+// emailVerificationTTL is how long a mailed email verification token stays valid
+const emailVerificationTTL = 48 * time.Hour
+
+// mailResendInterval is how long a user waits between verification emails, and between
+// password reset emails
+const mailResendInterval = time.Minute
+
+// Email verification methods
+func (s *LibraryService) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	token := strings.TrimSpace(req.Token)
+	if token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	user, err := s.userRepo.VerifyEmail(ctx, token)
+	if err != nil {
+		return nil, errorStatus(err, "failed to verify email")
+	}
+
+	return &pb.VerifyEmailResponse{User: user}, nil
+}
+
+func (s *LibraryService) ResendVerificationEmail(ctx context.Context, req *pb.ResendVerificationEmailRequest) (*pb.ResendVerificationEmailResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.mailer == nil {
+		return nil, status.Error(codes.Internal, "mailer is not configured")
+	}
+
+	if err := s.sendVerificationEmail(ctx, principal.UserID); err != nil {
+		return nil, errorStatus(err, "failed to send verification email")
+	}
+
+	return &pb.ResendVerificationEmailResponse{}, nil
+}
+
+// sendVerificationEmail mails a new email verification token to the user
+func (s *LibraryService) sendVerificationEmail(ctx context.Context, userID string) error {
+	token, err := newUserToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	user, err := s.userRepo.CreateEmailVerification(ctx, userID, token, time.Now().Add(emailVerificationTTL), mailResendInterval)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"Use this code to verify the email address of your library account:\n\n"+
+			"    %s\n\n"+
+			"You can borrow books once your address is verified. The code expires in %d hours.\n",
+			user.Name, token, int(emailVerificationTTL.Hours())),
+	})
+}
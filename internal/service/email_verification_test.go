@@ -0,0 +1,241 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mail"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test email verification with mocks
+func TestLibraryService_EmailVerification(t *testing.T) {
+	ctx := context.Background()
+	patronCtx := auth.NewContext(ctx, &auth.Principal{UserID: "user-id-1", Roles: []string{auth.RolePatron}})
+	pending := &pb.User{Id: "user-id-1", Name: "Ada", Email: "ada@example.com", Active: true}
+
+	t.Run("Register Mails Token", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		var outbox bytes.Buffer
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+		// Set up mock expectations
+		var token string
+		mockUserRepo.On("Create", ctx, "Ada", "ada@example.com", "password123").Return(pending, nil)
+		mockUserRepo.On("CreateEmailVerification", ctx, "user-id-1", mock.AnythingOfType("string"), mock.Anything, time.Minute).
+			Run(func(args mock.Arguments) {
+				token = args.String(2)
+			}).Return(pending, nil)
+
+		// Execute
+		response, err := svc.RegisterUser(ctx, &pb.RegisterUserRequest{Name: "Ada", Email: "ada@example.com", Password: "password123"})
+
+		// Verify: the new user is pending until the mailed token is redeemed
+		assert.NoError(t, err)
+		assert.False(t, response.User.EmailVerified)
+		assert.NotEmpty(t, token)
+		assert.Contains(t, outbox.String(), "To: ada@example.com")
+		assert.Contains(t, outbox.String(), token)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Register Survives Mail Failure", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&bytes.Buffer{}, "library@example.com")))
+
+		// Set up mock expectations
+		mockUserRepo.On("Create", ctx, "Ada", "ada@example.com", "password123").Return(pending, nil)
+		mockUserRepo.On("CreateEmailVerification", ctx, "user-id-1", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("connection refused"))
+
+		// Execute
+		response, err := svc.RegisterUser(ctx, &pb.RegisterUserRequest{Name: "Ada", Email: "ada@example.com", Password: "password123"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "user-id-1", response.User.Id)
+	})
+
+	t.Run("Email Change Mails Token", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		var outbox bytes.Buffer
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+		// Set up mock expectations
+		var token string
+		changed := &pb.User{Id: "user-id-1", Name: "Ada", Email: "ada@lovelace.example", Active: true}
+		mockUserRepo.On("UpdateProfile", patronCtx, "user-id-1", "", "ada@lovelace.example").Return(changed, nil)
+		mockUserRepo.On("CreateEmailVerification", patronCtx, "user-id-1", mock.AnythingOfType("string"), mock.Anything, time.Minute).
+			Run(func(args mock.Arguments) {
+				token = args.String(2)
+			}).Return(changed, nil)
+
+		// Execute
+		response, err := svc.UpdateUser(patronCtx, &pb.UpdateUserRequest{Email: "ada@lovelace.example"})
+
+		// Verify: the token goes to the new address
+		assert.NoError(t, err)
+		assert.False(t, response.User.EmailVerified)
+		assert.NotEmpty(t, token)
+		assert.Contains(t, outbox.String(), "To: ada@lovelace.example")
+		assert.Contains(t, outbox.String(), token)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Name Change Mails Nothing", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		var outbox bytes.Buffer
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+		// Set up mock expectation
+		mockUserRepo.On("UpdateProfile", patronCtx, "user-id-1", "Ada Lovelace", "").
+			Return(&pb.User{Id: "user-id-1", Name: "Ada Lovelace", Email: "ada@example.com", EmailVerified: true}, nil)
+
+		// Execute
+		_, err := svc.UpdateUser(patronCtx, &pb.UpdateUserRequest{Name: "Ada Lovelace"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Empty(t, outbox.String())
+		mockUserRepo.AssertNotCalled(t, "CreateEmailVerification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Verify", func(t *testing.T) {
+		cases := map[string]struct {
+			repoErr error
+			code    codes.Code
+		}{
+			"Verified":      {nil, codes.OK},
+			"Invalid Token": {repository.ErrInvalidVerificationToken, codes.InvalidArgument},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectation
+			var user *pb.User
+			if tc.repoErr == nil {
+				user = &pb.User{Id: "user-id-1", EmailVerified: true}
+			}
+			mockUserRepo.On("VerifyEmail", ctx, "token").Return(user, tc.repoErr)
+
+			// Execute
+			_, err := svc.VerifyEmail(ctx, &pb.VerifyEmailRequest{Token: " token "})
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(err), name)
+		}
+	})
+
+	t.Run("Resend", func(t *testing.T) {
+		cases := map[string]struct {
+			repoErr error
+			code    codes.Code
+		}{
+			"Sent":             {nil, codes.OK},
+			"Sent Recently":    {repository.ErrVerificationRateLimited, codes.ResourceExhausted},
+			"Already Verified": {repository.ErrEmailAlreadyVerified, codes.FailedPrecondition},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			var outbox bytes.Buffer
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+				service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+			// Set up mock expectation
+			var user *pb.User
+			if tc.repoErr == nil {
+				user = pending
+			}
+			mockUserRepo.On("CreateEmailVerification", patronCtx, "user-id-1", mock.Anything, mock.Anything, time.Minute).Return(user, tc.repoErr)
+
+			// Execute
+			_, err := svc.ResendVerificationEmail(patronCtx, &pb.ResendVerificationEmailRequest{})
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(err), name)
+			assert.Equal(t, tc.repoErr == nil, outbox.Len() > 0, name)
+		}
+	})
+
+	t.Run("Resend Without Mailer", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Execute
+		_, err := svc.ResendVerificationEmail(patronCtx, &pb.ResendVerificationEmailRequest{})
+
+		// Verify
+		assert.Equal(t, codes.Internal, status.Code(err))
+		mockUserRepo.AssertNotCalled(t, "CreateEmailVerification", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Borrow Requires Verified Email", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectation
+		mockBookRepo.On("BorrowBook", patronCtx, "user-id-1", "book-id-1", "", mock.Anything).Return(nil, repository.ErrEmailNotVerified)
+
+		// Execute
+		response, err := svc.BorrowBook(patronCtx, &pb.BorrowBookRequest{BookId: "book-id-1"})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/policy"
+	"library-management-service/internal/repository"
+)
+
+// kindCodes maps the kinds of domain errors to gRPC codes
+var kindCodes = map[error]codes.Code{
+	repository.ErrNotFound:        codes.NotFound,
+	repository.ErrDuplicate:       codes.AlreadyExists,
+	repository.ErrConflict:        codes.FailedPrecondition,
+	repository.ErrUnavailable:     codes.FailedPrecondition,
+	repository.ErrStale:           codes.Aborted,
+	repository.ErrInvalid:         codes.InvalidArgument,
+	repository.ErrUnauthenticated: codes.Unauthenticated,
+	repository.ErrForbidden:       codes.PermissionDenied,
+	repository.ErrRateLimited:     codes.ResourceExhausted,
+}
+
+// kindHints tell clients how to recover from the kinds of domain errors that are retried
+var kindHints = map[error]string{
+	repository.ErrStale:       "fetch it again and retry",
+	repository.ErrRateLimited: "try again later",
+}
+
+// errorStatus maps an error returned by a repository to a gRPC status. Domain errors and policy
+// violations get the code of their kind and an ErrorInfo detail carrying their machine-readable
+// reason, and invalid page tokens are InvalidArgument. Any other error is an Internal failure
+// described by message.
+func errorStatus(err error, message string) error {
+	if violation, ok := policy.AsViolation(err); ok {
+		return detailedStatus(codes.FailedPrecondition, violation.Message, violation.Reason, policy.Domain)
+	}
+	if errors.Is(err, pagination.ErrInvalidToken) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var domainErr *repository.Error
+	if !errors.As(err, &domainErr) {
+		return status.Errorf(codes.Internal, "%s: %v", message, err)
+	}
+	description := err.Error()
+	if hint, ok := kindHints[domainErr.Kind()]; ok {
+		description += "; " + hint
+	}
+	return detailedStatus(kindCodes[domainErr.Kind()], description, domainErr.Reason, repository.Domain)
+}
+
+// detailedStatus returns a status with an ErrorInfo detail, or without it should the detail fail
+// to encode
+func detailedStatus(code codes.Code, message, reason, domain string) error {
+	st := status.New(code, message)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: domain})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test that repository errors map to gRPC statuses with their reason in the details
+func TestLibraryService_ErrorStatus(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}})
+
+	cases := map[string]struct {
+		repoErr error
+		code    codes.Code
+		reason  string
+		message string
+	}{
+		"Not Found":    {repository.ErrBookNotFound, codes.NotFound, "BOOK_NOT_FOUND", "book not found"},
+		"Duplicate":    {repository.ErrDuplicateBarcode, codes.AlreadyExists, "DUPLICATE_BARCODE", "a copy with this barcode already exists"},
+		"Conflict":     {fmt.Errorf("%w: 1 must be returned first", repository.ErrBookOnLoan), codes.FailedPrecondition, "BOOK_ON_LOAN", "book has copies on loan: 1 must be returned first"},
+		"Stale":        {repository.ErrVersionConflict, codes.Aborted, "BOOK_VERSION_CONFLICT", "book was changed by someone else; fetch it again and retry"},
+		"Invalid":      {repository.ErrNotAFine, codes.InvalidArgument, "NOT_A_FINE", "ledger entry is not a fine"},
+		"Rate Limited": {repository.ErrVerificationRateLimited, codes.ResourceExhausted, "VERIFICATION_RATE_LIMITED", "a verification email was sent recently; try again later"},
+		"Database":     {errors.New("connection refused"), codes.Internal, "", "failed to add copy: connection refused"},
+	}
+
+	for name, tc := range cases {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectations
+		bookCopy := &pb.BookCopy{BookId: "book-id-1"}
+		mockBookRepo.On("GetByID", ctx, "book-id-1").Return(&pb.Book{Id: "book-id-1"}, nil)
+		mockBookRepo.On("AddCopy", ctx, bookCopy, mock.AnythingOfType("time.Time")).Return(nil, tc.repoErr)
+
+		// Execute
+		_, err := svc.AddBookCopy(ctx, &pb.AddBookCopyRequest{Copy: bookCopy})
+
+		// Verify
+		st := status.Convert(err)
+		assert.Equal(t, tc.code, st.Code(), name)
+		assert.Equal(t, tc.message, st.Message(), name)
+		if tc.reason == "" {
+			assert.Empty(t, st.Details(), name)
+		} else if assert.Len(t, st.Details(), 1, name) {
+			info := st.Details()[0].(*errdetails.ErrorInfo)
+			assert.Equal(t, tc.reason, info.Reason, name)
+			assert.Equal(t, repository.Domain, info.Domain, name)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Hold (reservation) methods
+func (s *LibraryService) PlaceHold(ctx context.Context, req *pb.PlaceHoldRequest) (*pb.PlaceHoldResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" {
+		req.UserId = principal.UserID
+	}
+	if req.UserId != principal.UserID && !isStaff(principal) {
+		return nil, status.Error(codes.PermissionDenied, "cannot place holds on behalf of another user")
+	}
+	if req.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book id is required")
+	}
+
+	if _, err := s.bookRepo.GetByID(ctx, req.BookId); err != nil {
+		return nil, errorStatus(err, "failed to get book")
+	}
+
+	hold, err := s.bookRepo.PlaceHold(ctx, req.UserId, req.BookId)
+	if err != nil {
+		if errors.Is(err, repository.ErrHoldNotNeeded) {
+			err = fmt.Errorf("%w; borrow it instead", err)
+		}
+		return nil, errorStatus(err, "failed to place hold")
+	}
+
+	return &pb.PlaceHoldResponse{Hold: hold}, nil
+}
+
+func (s *LibraryService) CancelHold(ctx context.Context, req *pb.CancelHoldRequest) (*pb.CancelHoldResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.HoldId == "" {
+		return nil, status.Error(codes.InvalidArgument, "hold id is required")
+	}
+
+	// Patrons may only cancel their own holds
+	hold, err := s.bookRepo.GetHold(ctx, req.HoldId)
+	if err != nil {
+		return nil, errorStatus(err, "failed to get hold")
+	}
+	if hold.UserId != principal.UserID && !isStaff(principal) {
+		return nil, status.Error(codes.PermissionDenied, "cannot cancel holds placed by another user")
+	}
+
+	hold, err = s.bookRepo.CancelHold(ctx, req.HoldId, s.holdExpiry())
+	if err != nil {
+		return nil, errorStatus(err, "failed to cancel hold")
+	}
+
+	return &pb.CancelHoldResponse{Hold: hold}, nil
+}
+
+func (s *LibraryService) ListHolds(ctx context.Context, req *pb.ListHoldsRequest) (*pb.ListHoldsResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Staff see every queue; patrons only their own holds
+	userID := req.UserId
+	if !isStaff(principal) {
+		if userID != "" && userID != principal.UserID {
+			return nil, status.Error(codes.PermissionDenied, "cannot list holds of another user")
+		}
+		userID = principal.UserID
+	}
+
+	holds, err := s.bookRepo.ListHolds(ctx, req.BookId, userID, req.IncludeClosed)
+	if err != nil {
+		return nil, errorStatus(err, "failed to list holds")
+	}
+
+	return &pb.ListHoldsResponse{Holds: holds}, nil
+}
+
+// ExpireHolds closes ready holds that were not picked up in time, passing their copies on to
+// the next patron in the queue. It is run periodically by the server.
+func (s *LibraryService) ExpireHolds(ctx context.Context) (int, error) {
+	return s.bookRepo.ExpireHolds(ctx, s.holdExpiry())
+}
+
+// holdExpiry is the pickup deadline for a hold that becomes ready now
+func (s *LibraryService) holdExpiry() time.Time {
+	return time.Now().Add(s.holdPickupWindow)
+}
@@ -0,0 +1,214 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test the hold queue methods with mocks
+func TestLibraryService_Holds(t *testing.T) {
+	patron := &auth.Principal{UserID: "patron-id", Roles: []string{auth.RolePatron}}
+	librarian := &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}}
+
+	t.Run("Place Hold Defaults To Caller", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Set up mock expectations
+		mockBookRepo.On("GetByID", ctx, "book-id-123").Return(&pb.Book{Id: "book-id-123"}, nil)
+		mockBookRepo.On("PlaceHold", ctx, "patron-id", "book-id-123").
+			Return(&pb.Hold{Id: "hold-id-1", Status: pb.HoldStatus_HOLD_STATUS_WAITING, QueuePosition: 2}, nil)
+
+		// Execute
+		response, err := svc.PlaceHold(ctx, &pb.PlaceHoldRequest{BookId: "book-id-123"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "hold-id-1", response.Hold.Id)
+		assert.Equal(t, int32(2), response.Hold.QueuePosition)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Place Hold For Other User Denied", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Execute
+		response, err := svc.PlaceHold(ctx, &pb.PlaceHoldRequest{BookId: "book-id-123", UserId: "someone-else"})
+
+		// Verify
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockBookRepo.AssertNotCalled(t, "PlaceHold", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Place Hold Errors", func(t *testing.T) {
+		cases := map[error]codes.Code{
+			repository.ErrHoldNotNeeded: codes.FailedPrecondition,
+			repository.ErrDuplicateHold: codes.AlreadyExists,
+			fmt.Errorf("boom"):          codes.Internal,
+		}
+
+		for repoErr, code := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Test data
+			ctx := auth.NewContext(context.Background(), patron)
+
+			// Set up mock expectations
+			mockBookRepo.On("GetByID", ctx, "book-id-123").Return(&pb.Book{Id: "book-id-123"}, nil)
+			mockBookRepo.On("PlaceHold", ctx, "patron-id", "book-id-123").Return(nil, repoErr)
+
+			// Execute
+			response, err := svc.PlaceHold(ctx, &pb.PlaceHoldRequest{BookId: "book-id-123"})
+
+			// Verify
+			assert.Nil(t, response)
+			assert.Equal(t, code, status.Code(err), repoErr.Error())
+		}
+	})
+
+	t.Run("Cancel Hold Of Other Patron Denied", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Set up mock expectation
+		mockBookRepo.On("GetHold", ctx, "hold-id-1").Return(&pb.Hold{Id: "hold-id-1", UserId: "someone-else"}, nil)
+
+		// Execute
+		response, err := svc.CancelHold(ctx, &pb.CancelHoldRequest{HoldId: "hold-id-1"})
+
+		// Verify
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockBookRepo.AssertNotCalled(t, "CancelHold", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Librarian Cancels Hold", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service with a one day pickup window
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithHoldPickupWindow(24*time.Hour))
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+		var capturedExpiry time.Time
+
+		// Set up mock expectations
+		mockBookRepo.On("GetHold", ctx, "hold-id-1").Return(&pb.Hold{Id: "hold-id-1", UserId: "patron-id"}, nil)
+		mockBookRepo.On("CancelHold", ctx, "hold-id-1", mock.AnythingOfType("time.Time")).
+			Run(func(args mock.Arguments) {
+				capturedExpiry = args.Get(2).(time.Time)
+			}).
+			Return(&pb.Hold{Id: "hold-id-1", Status: pb.HoldStatus_HOLD_STATUS_CANCELLED}, nil)
+
+		// Execute
+		response, err := svc.CancelHold(ctx, &pb.CancelHoldRequest{HoldId: "hold-id-1"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, pb.HoldStatus_HOLD_STATUS_CANCELLED, response.Hold.Status)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), capturedExpiry, time.Minute)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Patron Lists Own Holds", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Set up mock expectation: the user filter is forced to the caller
+		mockBookRepo.On("ListHolds", ctx, "book-id-123", "patron-id", false).Return([]*pb.Hold{{Id: "hold-id-1"}}, nil)
+
+		// Execute
+		response, err := svc.ListHolds(ctx, &pb.ListHoldsRequest{BookId: "book-id-123"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Len(t, response.Holds, 1)
+
+		// Another patron's holds are off limits
+		_, err = svc.ListHolds(ctx, &pb.ListHoldsRequest{UserId: "someone-else"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Borrow Unavailable Suggests Hold", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Set up mock expectation
+		mockBookRepo.On("BorrowBook", ctx, "patron-id", "book-id-123", "", mock.Anything).
+			Return(nil, repository.ErrBookUnavailable)
+
+		// Execute
+		response, err := svc.BorrowBook(ctx, &pb.BorrowBookRequest{BookId: "book-id-123"})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "place a hold")
+	})
+}
@@ -2,19 +2,132 @@ package service
 
 import (
 	"context"
-	"regexp"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mail"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/policy"
 	"library-management-service/internal/repository"
 	pb "library-management-service/proto/library/v1"
 )
 
+// DefaultHoldPickupWindow is how long a copy set aside for a hold waits for its patron
+const DefaultHoldPickupWindow = 7 * 24 * time.Hour
+
+// DefaultPasswordResetTTL is how long a mailed password reset token stays valid
+const DefaultPasswordResetTTL = time.Hour
+
+// DefaultRefreshTokenTTL is how long a refresh token stays valid; each refresh starts it afresh
+const DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+// Lockouts applied after failed logins, per account and per client IP address. An address sees
+// the failures of every account tried from it, so it is allowed more of them.
+var (
+	DefaultAccountLockout = repository.LoginLockout{Threshold: 5, BaseDelay: time.Minute, MaxDelay: time.Hour, Window: 24 * time.Hour}
+	DefaultIPLockout      = repository.LoginLockout{Threshold: 20, BaseDelay: time.Minute, MaxDelay: time.Hour, Window: time.Hour}
+)
+
+// Page sizes of list methods
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
 type LibraryService struct {
 	pb.UnimplementedLibraryServiceServer
-	userRepo repository.UserRepositoryInterface
-	bookRepo repository.BookRepositoryInterface
+	userRepo         repository.UserRepositoryInterface
+	bookRepo         repository.BookRepositoryInterface
+	tokens           auth.TokenIssuer
+	holdPickupWindow time.Duration
+	rules            policy.Resolver
+	pageTokens       *pagination.Codec
+	mailer           mail.Mailer
+	passwordResetTTL time.Duration
+	refreshTokenTTL  time.Duration
+	accountLockout   repository.LoginLockout
+	ipLockout        repository.LoginLockout
+	background       sync.WaitGroup // Work done off the request path
+}
+
+// PublicMethods lists the RPCs that may be called without an access token
+var PublicMethods = []string{
+	pb.LibraryService_RegisterUser_FullMethodName,
+	pb.LibraryService_LoginUser_FullMethodName,
+	pb.LibraryService_RefreshToken_FullMethodName,
+	pb.LibraryService_Logout_FullMethodName,
+	pb.LibraryService_RequestPasswordReset_FullMethodName,
+	pb.LibraryService_ConfirmPasswordReset_FullMethodName,
+	pb.LibraryService_VerifyEmail_FullMethodName,
+}
+
+// Option configures optional LibraryService dependencies
+type Option func(*LibraryService)
+
+// WithTokenIssuer sets the issuer used to mint access tokens in LoginUser and RefreshToken
+func WithTokenIssuer(issuer auth.TokenIssuer) Option {
+	return func(s *LibraryService) {
+		s.tokens = issuer
+	}
+}
+
+// WithHoldPickupWindow sets how long a ready hold keeps its copy set aside
+func WithHoldPickupWindow(window time.Duration) Option {
+	return func(s *LibraryService) {
+		s.holdPickupWindow = window
+	}
+}
+
+// WithPageTokens sets the codec that signs page tokens. Services sharing a codec key accept
+// each other's tokens. Without it the service signs with its own random key, whose tokens do
+// not survive a restart, so servers always set it.
+func WithPageTokens(codec *pagination.Codec) Option {
+	return func(s *LibraryService) {
+		s.pageTokens = codec
+	}
+}
+
+// WithCirculationPolicy sets the rules consulted when books are borrowed, renewed and returned
+func WithCirculationPolicy(rules policy.Resolver) Option {
+	return func(s *LibraryService) {
+		s.rules = rules
+	}
+}
+
+// WithMailer sets the mailer that delivers email verification and password reset tokens
+func WithMailer(mailer mail.Mailer) Option {
+	return func(s *LibraryService) {
+		s.mailer = mailer
+	}
+}
+
+// WithPasswordResetTTL sets how long a password reset token stays valid
+func WithPasswordResetTTL(ttl time.Duration) Option {
+	return func(s *LibraryService) {
+		s.passwordResetTTL = ttl
+	}
+}
+
+// WithRefreshTokenTTL sets how long a refresh token stays valid
+func WithRefreshTokenTTL(ttl time.Duration) Option {
+	return func(s *LibraryService) {
+		s.refreshTokenTTL = ttl
+	}
+}
+
+// WithLoginLockout sets the lockouts applied after failed logins with an account and from a
+// client IP address
+func WithLoginLockout(account, ip repository.LoginLockout) Option {
+	return func(s *LibraryService) {
+		s.accountLockout = account
+		s.ipLockout = ip
+	}
 }
 
 //	func NewLibraryService(userRepo *repository.UserRepository, bookRepo *repository.BookRepository) *LibraryService {
@@ -23,11 +136,30 @@ type LibraryService struct {
 //			bookRepo: bookRepo,
 //		}
 //	}
-func NewLibraryService(userRepo repository.UserRepositoryInterface, bookRepo repository.BookRepositoryInterface) *LibraryService {
-	return &LibraryService{
-		userRepo: userRepo,
-		bookRepo: bookRepo,
+func NewLibraryService(userRepo repository.UserRepositoryInterface, bookRepo repository.BookRepositoryInterface, opts ...Option) *LibraryService {
+	s := &LibraryService{
+		userRepo:         userRepo,
+		bookRepo:         bookRepo,
+		holdPickupWindow: DefaultHoldPickupWindow,
+		passwordResetTTL: DefaultPasswordResetTTL,
+		refreshTokenTTL:  DefaultRefreshTokenTTL,
+		accountLockout:   DefaultAccountLockout,
+		ipLockout:        DefaultIPLockout,
+		rules:            policy.NewEngine(policy.DefaultRules, nil),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.pageTokens == nil {
+		s.pageTokens = pagination.NewRandomCodec()
+	}
+	return s
+}
+
+// Wait blocks until the work started off the request path, such as mailing password resets,
+// has finished. Servers call it after they stop accepting requests.
+func (s *LibraryService) Wait() {
+	s.background.Wait()
 }
 
 // User-related methods
@@ -38,19 +170,26 @@ func (s *LibraryService) RegisterUser(ctx context.Context, req *pb.RegisterUserR
 	}
 
 	// Validate email format
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
-	if !emailRegex.MatchString(req.Email) {
+	if !emailPattern.MatchString(req.Email) {
 		return nil, status.Error(codes.InvalidArgument, "invalid email format")
 	}
 
 	// Validate password strength
-	if len(req.Password) < 8 {
-		return nil, status.Error(codes.InvalidArgument, "password must be at least 8 characters")
+	if len(req.Password) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
 	}
 
 	user, err := s.userRepo.Create(ctx, req.Name, req.Email, req.Password)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+		return nil, errorStatus(err, "failed to create user")
+	}
+
+	// New users are pending until they verify their email. Failing to send the verification
+	// does not undo the registration, as the user can ask for it again.
+	if s.mailer != nil {
+		if err := s.sendVerificationEmail(ctx, user.Id); err != nil {
+			log.Printf("Failed to send verification email to user %s: %v", user.Id, err)
+		}
 	}
 
 	return &pb.RegisterUserResponse{User: user}, nil
@@ -61,36 +200,144 @@ func (s *LibraryService) LoginUser(ctx context.Context, req *pb.LoginUserRequest
 		return nil, status.Error(codes.InvalidArgument, "email and password are required")
 	}
 
+	// Failed logins lock out further attempts with the account and from the client's address
+	accountKey, ipKey := accountThrottleKey(req.Email), ipThrottleKey(auth.ClientIPFromContext(ctx))
+	lockedUntil, err := s.userRepo.LoginLockedUntil(ctx, accountKey, ipKey)
+	if err != nil {
+		return nil, errorStatus(err, "failed to check login lockout")
+	}
+	if !lockedUntil.IsZero() {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many failed login attempts; try again after %s", lockedUntil.Format(time.RFC3339))
+	}
+
 	user, err := s.userRepo.VerifyCredentials(ctx, req.Email, req.Password)
 	if err != nil {
+		if !errors.Is(err, repository.ErrInvalidCredentials) {
+			return nil, errorStatus(err, "failed to verify credentials")
+		}
+		s.recordLoginFailure(ctx, accountKey, ipKey)
 		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
 	}
 
-	// In a real implementation, you'd generate a JWT token here
-	token := "sample-jwt-token"
+	// A successful login forgets the account's failures, but not those of the address
+	if err := s.userRepo.ClearLoginFailures(ctx, accountKey); err != nil {
+		log.Printf("Failed to clear login failures of user %s: %v", user.Id, err)
+	}
+
+	if s.tokens == nil {
+		return nil, status.Error(codes.Internal, "token issuer is not configured")
+	}
+
+	token, expiresAt, err := s.tokens.Issue(user.Id, repository.RoleNames(user.Roles))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue token: %v", err)
+	}
+
+	refreshToken, err := newUserToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate refresh token: %v", err)
+	}
+	refreshExpiresAt := time.Now().Add(s.refreshTokenTTL)
+	if err := s.userRepo.CreateSession(ctx, user.Id, refreshToken, refreshExpiresAt); err != nil {
+		return nil, errorStatus(err, "failed to start session")
+	}
 
 	return &pb.LoginUserResponse{
-		User:  user,
-		Token: token,
+		User:             user,
+		Token:            token,
+		ExpiresAt:        expiresAt.Format(time.RFC3339),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt.Format(time.RFC3339),
 	}, nil
 }
 
+// Admin methods
+func (s *LibraryService) GrantRole(ctx context.Context, req *pb.GrantRoleRequest) (*pb.GrantRoleResponse, error) {
+	if _, err := authorize(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" || req.Role == pb.Role_ROLE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "user id and role are required")
+	}
+
+	user, err := s.userRepo.GrantRole(ctx, req.UserId, req.Role)
+	if err != nil {
+		return nil, errorStatus(err, "failed to grant role")
+	}
+
+	return &pb.GrantRoleResponse{User: user}, nil
+}
+
+func (s *LibraryService) RevokeRole(ctx context.Context, req *pb.RevokeRoleRequest) (*pb.RevokeRoleResponse, error) {
+	principal, err := authorize(ctx, auth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" || req.Role == pb.Role_ROLE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "user id and role are required")
+	}
+
+	// Keep at least one admin able to manage roles
+	if req.UserId == principal.UserID && req.Role == pb.Role_ROLE_ADMIN {
+		return nil, status.Error(codes.FailedPrecondition, "admins cannot revoke their own admin role")
+	}
+
+	user, err := s.userRepo.RevokeRole(ctx, req.UserId, req.Role)
+	if err != nil {
+		return nil, errorStatus(err, "failed to revoke role")
+	}
+
+	return &pb.RevokeRoleResponse{User: user}, nil
+}
+
+func (s *LibraryService) SetPatronType(ctx context.Context, req *pb.SetPatronTypeRequest) (*pb.SetPatronTypeResponse, error) {
+	if _, err := authorize(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" || req.PatronType == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id and patron type are required")
+	}
+	if req.PatronType == policy.Any {
+		return nil, status.Errorf(codes.InvalidArgument, "%q is reserved for policy rules", policy.Any)
+	}
+
+	user, err := s.userRepo.SetPatronType(ctx, req.UserId, req.PatronType)
+	if err != nil {
+		return nil, errorStatus(err, "failed to set patron type")
+	}
+
+	return &pb.SetPatronTypeResponse{User: user}, nil
+}
+
 // Book-related methods
 func (s *LibraryService) CreateBook(ctx context.Context, req *pb.CreateBookRequest) (*pb.CreateBookResponse, error) {
+	if _, err := authorize(ctx, staffRoles...); err != nil {
+		return nil, err
+	}
+
 	if req.Book == nil {
 		return nil, status.Error(codes.InvalidArgument, "book is required")
 	}
 
-	if req.Book.Title == "" || req.Book.Author == "" {
-		return nil, status.Error(codes.InvalidArgument, "title and author are required")
+	if err := validateBook(req.Book); err != nil {
+		return nil, err
+	}
+
+	for _, bookCopy := range req.Copies {
+		if err := validateNewCopy(bookCopy); err != nil {
+			return nil, err
+		}
 	}
 
-	book, err := s.bookRepo.Create(ctx, req.Book)
+	book, copies, err := s.bookRepo.Create(ctx, req.Book, req.Copies)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create book: %v", err)
+		return nil, errorStatus(err, "failed to create book")
 	}
 
-	return &pb.CreateBookResponse{Book: book}, nil
+	return &pb.CreateBookResponse{Book: book, Copies: copies}, nil
 }
 
 func (s *LibraryService) GetBook(ctx context.Context, req *pb.GetBookRequest) (*pb.GetBookResponse, error) {
@@ -100,61 +347,122 @@ func (s *LibraryService) GetBook(ctx context.Context, req *pb.GetBookRequest) (*
 
 	book, err := s.bookRepo.GetByID(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "book not found: %v", err)
+		return nil, errorStatus(err, "failed to get book")
 	}
 
 	return &pb.GetBookResponse{Book: book}, nil
 }
 
 func (s *LibraryService) ListBooks(ctx context.Context, req *pb.ListBooksRequest) (*pb.ListBooksResponse, error) {
-	pageSize := int32(10) // Default page size
+	pageSize := int32(DefaultPageSize)
 	if req.PageSize > 0 {
-		pageSize = req.PageSize
+		pageSize = min(req.PageSize, MaxPageSize)
 	}
 
-	// In a real application, you'd implement proper pagination with tokens
-	// For simplicity, we'll just use an offset of 0
-	books, err := s.bookRepo.List(ctx, pageSize, 0)
+	var after *pagination.Cursor
+	if req.PageToken != "" {
+		cursor, err := s.pageTokens.Decode(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		// Tokens of scoped listings, such as searches, hold positions in another order
+		if cursor.Scope != "" {
+			return nil, status.Error(codes.InvalidArgument, "page token was not issued by ListBooks")
+		}
+		after = &cursor
+	}
+
+	// Fetch one extra book to learn whether there is a next page
+	books, err := s.bookRepo.List(ctx, pageSize+1, after)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list books: %v", err)
+		return nil, errorStatus(err, "failed to list books")
 	}
 
-	return &pb.ListBooksResponse{
-		Books: books,
-	}, nil
+	response := &pb.ListBooksResponse{Books: books}
+	if len(books) > int(pageSize) {
+		response.Books = books[:pageSize]
+		last := response.Books[pageSize-1]
+		response.NextPageToken = s.pageTokens.Encode(pagination.Cursor{Key: last.Title, ID: last.Id})
+	}
+
+	if req.IncludeTotalCount {
+		response.TotalCount, err = s.bookRepo.Count(ctx)
+		if err != nil {
+			return nil, errorStatus(err, "failed to count books")
+		}
+	}
+
+	return response, nil
 }
 
 func (s *LibraryService) BorrowBook(ctx context.Context, req *pb.BorrowBookRequest) (*pb.BorrowBookResponse, error) {
-	if req.UserId == "" || req.BookId == "" {
-		return nil, status.Error(codes.InvalidArgument, "user id and book id are required")
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	// Set due date to 14 days from now
-	dueDate := time.Now().AddDate(0, 0, 14)
+	// Callers borrow for themselves; only staff may check out books to someone else
+	if req.UserId == "" {
+		req.UserId = principal.UserID
+	} else if req.UserId != principal.UserID && !isStaff(principal) {
+		return nil, status.Error(codes.PermissionDenied, "cannot borrow books on behalf of another user")
+	}
 
-	borrowID, err := s.bookRepo.BorrowBook(ctx, req.UserId, req.BookId, dueDate)
+	if req.BookId == "" && req.CopyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book id or copy id is required")
+	}
+
+	// The circulation policy sets the due date and the loan limit
+	borrow, err := s.bookRepo.BorrowBook(ctx, req.UserId, req.BookId, req.CopyId, s.rules)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to borrow book: %v", err)
+		switch {
+		case errors.Is(err, repository.ErrUnavailable):
+			err = fmt.Errorf("%w; place a hold to join the waiting list", err)
+		case errors.Is(err, repository.ErrEmailNotVerified):
+			err = fmt.Errorf("%w; verify it to borrow books", err)
+		}
+		return nil, errorStatus(err, "failed to borrow book")
 	}
 
 	return &pb.BorrowBookResponse{
-		BorrowId: borrowID,
-		DueDate:  dueDate.Format(time.RFC3339),
+		BorrowId: borrow.ID,
+		DueDate:  borrow.DueDate.Format(time.RFC3339),
+		CopyId:   borrow.CopyID,
+		Barcode:  borrow.Barcode,
 	}, nil
 }
 
 func (s *LibraryService) ReturnBook(ctx context.Context, req *pb.ReturnBookRequest) (*pb.ReturnBookResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.BorrowId == "" {
 		return nil, status.Error(codes.InvalidArgument, "borrow id is required")
 	}
 
-	err := s.bookRepo.ReturnBook(ctx, req.BorrowId)
+	// Patrons may only return their own borrows; staff process returns for anyone
+	if !isStaff(principal) {
+		borrowerID, err := s.bookRepo.GetBorrowerID(ctx, req.BorrowId)
+		if err != nil {
+			return nil, errorStatus(err, "failed to get borrow")
+		}
+		if borrowerID != principal.UserID {
+			return nil, status.Error(codes.PermissionDenied, "cannot return books borrowed by another user")
+		}
+	}
+
+	returned, err := s.bookRepo.ReturnBook(ctx, req.BorrowId, s.holdExpiry(), s.rules)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to return book: %v", err)
+		return nil, errorStatus(err, "failed to return book")
 	}
 
 	return &pb.ReturnBookResponse{
-		Success: true,
+		Success:     true,
+		HoldId:      returned.HoldID,
+		DaysOverdue: int32(returned.DaysOverdue),
+		FineCents:   returned.FineCents,
 	}, nil
 }
 
@@ -165,7 +473,7 @@ func (s *LibraryService) CheckBookAvailability(ctx context.Context, req *pb.Chec
 
 	book, err := s.bookRepo.GetByID(ctx, req.BookId)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "book not found: %v", err)
+		return nil, errorStatus(err, "failed to get book")
 	}
 
 	statusMsg := "Borrowed"
@@ -174,7 +482,9 @@ func (s *LibraryService) CheckBookAvailability(ctx context.Context, req *pb.Chec
 	}
 
 	return &pb.CheckBookAvailabilityResponse{
-		Available: book.Available,
-		Status:    statusMsg,
+		Available:       book.Available,
+		Status:          statusMsg,
+		TotalCopies:     book.TotalCopies,
+		AvailableCopies: book.AvailableCopies,
 	}, nil
 }
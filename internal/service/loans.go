@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Loan history methods
+func (s *LibraryService) ListUserLoans(ctx context.Context, req *pb.ListUserLoansRequest) (*pb.ListUserLoansResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Patrons see their own loans; staff see anyone's
+	if req.UserId == "" {
+		req.UserId = principal.UserID
+	} else if req.UserId != principal.UserID && !isStaff(principal) {
+		return nil, status.Error(codes.PermissionDenied, "cannot list the loans of another user")
+	}
+
+	if _, ok := pb.LoanState_name[int32(req.State)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid loan state")
+	}
+
+	filter := repository.LoanFilter{UserID: req.UserId, State: req.State}
+	loans, nextPageToken, err := s.listLoans(ctx, filter, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListUserLoansResponse{Loans: loans, NextPageToken: nextPageToken}, nil
+}
+
+func (s *LibraryService) ListBookLoanHistory(ctx context.Context, req *pb.ListBookLoanHistoryRequest) (*pb.ListBookLoanHistoryResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book id is required")
+	}
+
+	// Who borrowed a title is private: patrons only see their own loans of it
+	filter := repository.LoanFilter{BookID: req.BookId}
+	if !isStaff(principal) {
+		filter.UserID = principal.UserID
+	}
+
+	loans, nextPageToken, err := s.listLoans(ctx, filter, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListBookLoanHistoryResponse{Loans: loans, NextPageToken: nextPageToken}, nil
+}
+
+// listLoans returns a page of the loans matching filter and the token of the next page
+func (s *LibraryService) listLoans(ctx context.Context, filter repository.LoanFilter, pageSize int32, pageToken string) ([]*pb.Loan, string, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	pageSize = min(pageSize, MaxPageSize)
+
+	// Tokens are tied to the listing they came from, so they cannot page through another user's loans
+	scope := loansScope(filter)
+	var after *pagination.Cursor
+	if pageToken != "" {
+		cursor, err := s.pageTokens.Decode(pageToken)
+		if err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, err.Error())
+		}
+		if cursor.Scope != scope {
+			return nil, "", status.Error(codes.InvalidArgument, "page token was issued for a different listing")
+		}
+		after = &cursor
+	}
+
+	// Fetch one extra loan to learn whether there is a next page
+	loans, err := s.bookRepo.ListLoans(ctx, filter, pageSize+1, after)
+	if err != nil {
+		return nil, "", errorStatus(err, "failed to list loans")
+	}
+
+	nextPageToken := ""
+	if len(loans) > int(pageSize) {
+		loans = loans[:pageSize]
+		last := loans[pageSize-1]
+		nextPageToken = s.pageTokens.Encode(pagination.Cursor{Key: repository.LoanKey(last), ID: last.Id, Scope: scope})
+	}
+
+	return loans, nextPageToken, nil
+}
+
+// loansScope identifies a loan listing for its page tokens
+func loansScope(filter repository.LoanFilter) string {
+	return strings.Join([]string{"loans", filter.UserID, filter.BookID, repository.LoanStateName(filter.State)}, ":")
+}
@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test ListUserLoans and ListBookLoanHistory with mocks
+func TestLibraryService_Loans(t *testing.T) {
+	patronCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "user-id-1", Roles: []string{auth.RolePatron}})
+	librarianCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}})
+	codec := pagination.NewCodec([]byte("test-page-token-key"))
+
+	t.Run("Own Loans", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithPageTokens(codec))
+
+		// Set up mock expectation: one extra loan tells that there is a next page
+		loans := []*pb.Loan{
+			{Id: "borrow-id-3", BorrowedAt: "2024-03-03T10:00:00Z"},
+			{Id: "borrow-id-2", BorrowedAt: "2024-03-02T10:00:00Z"},
+			{Id: "borrow-id-1", BorrowedAt: "2024-03-01T10:00:00Z"},
+		}
+		filter := repository.LoanFilter{UserID: "user-id-1", State: pb.LoanState_LOAN_STATE_ACTIVE}
+		mockBookRepo.On("ListLoans", patronCtx, filter, int32(3), (*pagination.Cursor)(nil)).Return(loans, nil)
+
+		// Execute
+		response, err := svc.ListUserLoans(patronCtx, &pb.ListUserLoansRequest{State: pb.LoanState_LOAN_STATE_ACTIVE, PageSize: 2})
+
+		// Verify
+		require.NoError(t, err)
+		assert.Len(t, response.Loans, 2)
+
+		cursor, err := codec.Decode(response.NextPageToken)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-02T10:00:00Z", cursor.Key)
+		assert.Equal(t, "borrow-id-2", cursor.ID)
+
+		// The token pages through the same listing only
+		_, err = svc.ListUserLoans(patronCtx, &pb.ListUserLoansRequest{PageToken: response.NextPageToken})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Loans Of Another User", func(t *testing.T) {
+		cases := map[string]struct {
+			ctx  context.Context
+			code codes.Code
+		}{
+			"Patron":    {patronCtx, codes.PermissionDenied},
+			"Librarian": {librarianCtx, codes.OK},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectation
+			mockBookRepo.On("ListLoans", tc.ctx, repository.LoanFilter{UserID: "user-id-2"}, int32(service.DefaultPageSize+1), (*pagination.Cursor)(nil)).Return([]*pb.Loan{}, nil)
+
+			// Execute
+			_, err := svc.ListUserLoans(tc.ctx, &pb.ListUserLoansRequest{UserId: "user-id-2"})
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(err), name)
+		}
+	})
+
+	t.Run("Book History", func(t *testing.T) {
+		cases := map[string]struct {
+			ctx    context.Context
+			filter repository.LoanFilter
+		}{
+			// Patrons only learn about their own loans of the title
+			"Patron":    {patronCtx, repository.LoanFilter{BookID: "book-id-1", UserID: "user-id-1"}},
+			"Librarian": {librarianCtx, repository.LoanFilter{BookID: "book-id-1"}},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectation
+			mockBookRepo.On("ListLoans", tc.ctx, tc.filter, mock.Anything, mock.Anything).Return([]*pb.Loan{{Id: "borrow-id-1"}}, nil)
+
+			// Execute
+			response, err := svc.ListBookLoanHistory(tc.ctx, &pb.ListBookLoanHistoryRequest{BookId: "book-id-1"})
+
+			// Verify
+			assert.NoError(t, err, name)
+			assert.Len(t, response.Loans, 1, name)
+			assert.Empty(t, response.NextPageToken, name)
+			mockBookRepo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("Invalid Requests", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Execute
+		_, missingBookErr := svc.ListBookLoanHistory(librarianCtx, &pb.ListBookLoanHistoryRequest{})
+		_, stateErr := svc.ListUserLoans(patronCtx, &pb.ListUserLoansRequest{State: pb.LoanState(42)})
+		_, tokenErr := svc.ListUserLoans(patronCtx, &pb.ListUserLoansRequest{PageToken: "not-a-token"})
+		_, unauthenticatedErr := svc.ListUserLoans(context.Background(), &pb.ListUserLoansRequest{})
+
+		// Verify
+		assert.Equal(t, codes.InvalidArgument, status.Code(missingBookErr))
+		assert.Equal(t, codes.InvalidArgument, status.Code(stateErr))
+		assert.Equal(t, codes.InvalidArgument, status.Code(tokenErr))
+		assert.Equal(t, codes.Unauthenticated, status.Code(unauthenticatedErr))
+		mockBookRepo.AssertNotCalled(t, "ListLoans", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/auth"
+	pb "library-management-service/proto/library/v1"
+)
+
+// accountThrottleKey returns the key failed logins with an email are counted under. Emails that
+// are not registered are counted as well, so lockouts do not reveal which ones are.
+func accountThrottleKey(email string) string {
+	return "account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// ipThrottleKey returns the key failed logins from a client IP address are counted under, or ""
+// when the address is unknown
+func ipThrottleKey(ip string) string {
+	if ip == "" {
+		return ""
+	}
+	if parsed := net.ParseIP(ip); parsed != nil {
+		ip = parsed.String()
+	}
+	return "ip:" + ip
+}
+
+// recordLoginFailure counts a failed login against the account and the client's address. The
+// login has failed either way, so errors are only logged.
+func (s *LibraryService) recordLoginFailure(ctx context.Context, accountKey, ipKey string) {
+	if _, err := s.userRepo.RecordLoginFailure(ctx, accountKey, s.accountLockout); err != nil {
+		log.Printf("Failed to record login failure for %s: %v", accountKey, err)
+	}
+	if ipKey == "" {
+		return
+	}
+	if _, err := s.userRepo.RecordLoginFailure(ctx, ipKey, s.ipLockout); err != nil {
+		log.Printf("Failed to record login failure for %s: %v", ipKey, err)
+	}
+}
+
+func (s *LibraryService) UnlockLogin(ctx context.Context, req *pb.UnlockLoginRequest) (*pb.UnlockLoginResponse, error) {
+	if _, err := authorize(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req.UserId == "" && req.IpAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id or ip address is required")
+	}
+
+	var keys []string
+	if req.UserId != "" {
+		user, err := s.userRepo.GetByID(ctx, req.UserId)
+		if err != nil {
+			return nil, errorStatus(err, "failed to get user")
+		}
+		keys = append(keys, accountThrottleKey(user.Email))
+	}
+	if req.IpAddress != "" {
+		if net.ParseIP(req.IpAddress) == nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid ip address")
+		}
+		keys = append(keys, ipThrottleKey(req.IpAddress))
+	}
+
+	if err := s.userRepo.ClearLoginFailures(ctx, keys...); err != nil {
+		return nil, errorStatus(err, "failed to unlock login")
+	}
+
+	return &pb.UnlockLoginResponse{}, nil
+}
@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test login lockouts and UnlockLogin with mocks
+func TestLibraryService_LoginThrottle(t *testing.T) {
+	ctx := auth.NewClientIPContext(context.Background(), "192.0.2.1")
+	adminCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin-id", Roles: []string{auth.RoleAdmin}})
+	keys := []string{"account:ada@example.com", "ip:192.0.2.1"}
+	request := &pb.LoginUserRequest{Email: "Ada@Example.com", Password: "password123"}
+
+	t.Run("Locked Out", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithTokenIssuer(newTestTokenManager(t)))
+
+		// Set up mock expectation
+		mockUserRepo.On("LoginLockedUntil", ctx, keys).Return(time.Now().Add(time.Minute), nil)
+
+		// Execute
+		response, err := svc.LoginUser(ctx, request)
+
+		// Verify: the password is not even checked
+		assert.Nil(t, response)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		mockUserRepo.AssertNotCalled(t, "VerifyCredentials", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failure Counts Against Account And Address", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		accountLockout := repository.LoginLockout{Threshold: 3, BaseDelay: time.Second, MaxDelay: time.Minute, Window: time.Hour}
+		ipLockout := repository.LoginLockout{Threshold: 10, BaseDelay: time.Second, MaxDelay: time.Minute, Window: time.Hour}
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithTokenIssuer(newTestTokenManager(t)),
+			service.WithLoginLockout(accountLockout, ipLockout))
+
+		// Set up mock expectations
+		mockUserRepo.On("LoginLockedUntil", ctx, keys).Return(time.Time{}, nil)
+		mockUserRepo.On("VerifyCredentials", ctx, "Ada@Example.com", "password123").Return(nil, repository.ErrInvalidCredentials)
+		mockUserRepo.On("RecordLoginFailure", ctx, "account:ada@example.com", accountLockout).Return(time.Time{}, nil)
+		mockUserRepo.On("RecordLoginFailure", ctx, "ip:192.0.2.1", ipLockout).Return(time.Time{}, nil)
+
+		// Execute
+		response, err := svc.LoginUser(ctx, request)
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unlock", func(t *testing.T) {
+		cases := map[string]struct {
+			request *pb.UnlockLoginRequest
+			keys    []string
+		}{
+			"Account":             {&pb.UnlockLoginRequest{UserId: "user-id-1"}, []string{"account:ada@example.com"}},
+			"Address":             {&pb.UnlockLoginRequest{IpAddress: "2001:DB8::1"}, []string{"ip:2001:db8::1"}},
+			"Account And Address": {&pb.UnlockLoginRequest{UserId: "user-id-1", IpAddress: "192.0.2.1"}, keys},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectations
+			mockUserRepo.On("GetByID", adminCtx, "user-id-1").Return(&pb.User{Id: "user-id-1", Email: "Ada@example.com"}, nil)
+			mockUserRepo.On("ClearLoginFailures", adminCtx, tc.keys).Return(nil)
+
+			// Execute
+			_, err := svc.UnlockLogin(adminCtx, tc.request)
+
+			// Verify
+			assert.NoError(t, err, name)
+			mockUserRepo.AssertCalled(t, "ClearLoginFailures", adminCtx, tc.keys)
+		}
+	})
+
+	t.Run("Unlock Refused", func(t *testing.T) {
+		patronCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "user-id-1", Roles: []string{auth.RolePatron}})
+
+		cases := map[string]struct {
+			ctx     context.Context
+			request *pb.UnlockLoginRequest
+			code    codes.Code
+		}{
+			"Not Admin":       {patronCtx, &pb.UnlockLoginRequest{UserId: "user-id-1"}, codes.PermissionDenied},
+			"Nothing To Lift": {adminCtx, &pb.UnlockLoginRequest{}, codes.InvalidArgument},
+			"Invalid Address": {adminCtx, &pb.UnlockLoginRequest{IpAddress: "not-an-ip"}, codes.InvalidArgument},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Execute
+			_, err := svc.UnlockLogin(tc.ctx, tc.request)
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(err), name)
+			mockUserRepo.AssertNotCalled(t, "ClearLoginFailures", mock.Anything, mock.Anything)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mail"
+	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
+)
+
+// passwordResetLockout limits the password reset requests from a client IP address, so that
+// the endpoint cannot be used to flood inboxes
+var passwordResetLockout = repository.LoginLockout{Threshold: 10, BaseDelay: time.Minute, MaxDelay: time.Hour, Window: time.Hour}
+
+// passwordResetMailTimeout bounds the creation and mailing of a reset off the request path
+const passwordResetMailTimeout = time.Minute
+
+// Password reset methods. Both are public: the caller has forgotten their password.
+func (s *LibraryService) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	if s.mailer == nil {
+		return nil, status.Error(codes.Internal, "mailer is not configured")
+	}
+
+	// Every request counts against the client's address, whether or not the email is registered
+	if ipKey := ipThrottleKey(auth.ClientIPFromContext(ctx)); ipKey != "" {
+		ipKey = "password-reset:" + ipKey
+		lockedUntil, err := s.userRepo.LoginLockedUntil(ctx, ipKey)
+		if err != nil {
+			return nil, errorStatus(err, "failed to check password reset limit")
+		}
+		if !lockedUntil.IsZero() {
+			return nil, status.Errorf(codes.ResourceExhausted, "too many password reset requests; try again after %s", lockedUntil.Format(time.RFC3339))
+		}
+		if _, err := s.userRepo.RecordLoginFailure(ctx, ipKey, passwordResetLockout); err != nil {
+			return nil, errorStatus(err, "failed to record password reset request")
+		}
+	}
+
+	// Answer before looking the email up, so that response times do not reveal which emails
+	// are registered
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), passwordResetMailTimeout)
+		defer cancel()
+		if err := s.sendPasswordReset(ctx, email); err != nil {
+			log.Printf("Failed to send password reset: %v", err)
+		}
+	}()
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+// sendPasswordReset mails a reset token to the active user registered with email. Unknown and
+// unverified emails, and users who were sent a reset recently, are not mailed.
+func (s *LibraryService) sendPasswordReset(ctx context.Context, email string) error {
+	token, err := newUserToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	user, err := s.userRepo.CreatePasswordReset(ctx, email, token, time.Now().Add(s.passwordResetTTL), mailResendInterval)
+	if errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, repository.ErrPasswordResetRateLimited) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Reset your library password",
+		Body: fmt.Sprintf("Hello %s,\n\n"+
+			"Use this code to choose a new password for your library account:\n\n"+
+			"    %s\n\n"+
+			"The code can be used once and expires in %d minutes. If you did not ask to reset your "+
+			"password, you can ignore this email.\n", user.Name, token, int(s.passwordResetTTL.Minutes())),
+	})
+}
+
+func (s *LibraryService) ConfirmPasswordReset(ctx context.Context, req *pb.ConfirmPasswordResetRequest) (*pb.ConfirmPasswordResetResponse, error) {
+	if req.Token == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and new password are required")
+	}
+	if len(req.NewPassword) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+
+	if err := s.userRepo.ResetPassword(ctx, strings.TrimSpace(req.Token), req.NewPassword); err != nil {
+		return nil, errorStatus(err, "failed to reset password")
+	}
+
+	return &pb.ConfirmPasswordResetResponse{}, nil
+}
+
+// newUserToken returns a random token to be mailed or handed to a user, with 256 bits of entropy
+func newUserToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
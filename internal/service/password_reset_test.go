@@ -0,0 +1,214 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mail"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test RequestPasswordReset and ConfirmPasswordReset with mocks
+func TestLibraryService_PasswordReset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Request Mails Token", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		var outbox bytes.Buffer
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")),
+			service.WithPasswordResetTTL(30*time.Minute))
+
+		// Set up mock expectation: the token expires after the configured TTL, and is mailed at
+		// most once a minute
+		var token string
+		mockUserRepo.On("CreatePasswordReset", mock.Anything, "ada@example.com", mock.AnythingOfType("string"), mock.MatchedBy(func(expiresAt time.Time) bool {
+			return time.Until(expiresAt) > 29*time.Minute && time.Until(expiresAt) <= 30*time.Minute
+		}), time.Minute).Run(func(args mock.Arguments) {
+			token = args.String(2)
+		}).Return(&pb.User{Id: "user-id-1", Name: "Ada", Email: "ada@example.com"}, nil)
+
+		// Execute
+		_, err := svc.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: " ada@example.com "})
+		svc.Wait()
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Len(t, token, 43)
+		assert.Contains(t, outbox.String(), "To: ada@example.com")
+		assert.Contains(t, outbox.String(), token)
+		assert.Contains(t, outbox.String(), "expires in 30 minutes")
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Request Not Mailed", func(t *testing.T) {
+		cases := map[string]error{
+			"Unknown Email": repository.ErrUserNotFound,
+			"Sent Recently": repository.ErrPasswordResetRateLimited,
+		}
+
+		for name, repoErr := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			var outbox bytes.Buffer
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+				service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+			// Set up mock expectation
+			mockUserRepo.On("CreatePasswordReset", mock.Anything, "ada@example.com", mock.Anything, mock.Anything, mock.Anything).Return(nil, repoErr)
+
+			// Execute
+			response, err := svc.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "ada@example.com"})
+			svc.Wait()
+
+			// Verify: the caller cannot tell, and nothing is sent
+			assert.NoError(t, err, name)
+			assert.NotNil(t, response, name)
+			assert.Empty(t, outbox.String(), name)
+			mockUserRepo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("Request Answers Before Mailing", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		var outbox bytes.Buffer
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+		// Set up mock expectation: the lookup is still running when the response is sent
+		lookup := make(chan struct{})
+		mockUserRepo.On("CreatePasswordReset", mock.Anything, "ada@example.com", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { <-lookup }).Return(nil, repository.ErrUserNotFound)
+
+		// Execute
+		_, err := svc.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "ada@example.com"})
+
+		// Verify
+		assert.NoError(t, err)
+		close(lookup)
+		svc.Wait()
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Request Throttled By Address", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		var outbox bytes.Buffer
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+		// Set up mock expectation: the address is locked out
+		ctx := auth.NewClientIPContext(context.Background(), "192.0.2.1")
+		mockUserRepo.On("LoginLockedUntil", ctx, []string{"password-reset:ip:192.0.2.1"}).Return(time.Now().Add(time.Minute), nil)
+
+		// Execute
+		_, err := svc.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "ada@example.com"})
+		svc.Wait()
+
+		// Verify
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		mockUserRepo.AssertNotCalled(t, "RecordLoginFailure", mock.Anything, mock.Anything, mock.Anything)
+		mockUserRepo.AssertNotCalled(t, "CreatePasswordReset", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Request Counted Against Address", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		var outbox bytes.Buffer
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithMailer(mail.NewLogMailer(&outbox, "library@example.com")))
+
+		// Set up mock expectations
+		ctx := auth.NewClientIPContext(context.Background(), "192.0.2.1")
+		mockUserRepo.On("LoginLockedUntil", ctx, []string{"password-reset:ip:192.0.2.1"}).Return(time.Time{}, nil)
+		mockUserRepo.On("RecordLoginFailure", ctx, "password-reset:ip:192.0.2.1", mock.Anything).Return(time.Time{}, nil)
+		mockUserRepo.On("CreatePasswordReset", mock.Anything, "nobody@example.com", mock.Anything, mock.Anything, mock.Anything).Return(nil, repository.ErrUserNotFound)
+
+		// Execute
+		_, err := svc.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "nobody@example.com"})
+		svc.Wait()
+
+		// Verify
+		assert.NoError(t, err)
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Request Without Mailer", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Execute
+		_, err := svc.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{Email: "ada@example.com"})
+
+		// Verify
+		assert.Equal(t, codes.Internal, status.Code(err))
+		mockUserRepo.AssertNotCalled(t, "CreatePasswordReset", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		cases := map[string]struct {
+			request *pb.ConfirmPasswordResetRequest
+			repoErr error
+			code    codes.Code
+		}{
+			"Reset":          {&pb.ConfirmPasswordResetRequest{Token: "token", NewPassword: "correct horse"}, nil, codes.OK},
+			"Invalid Token":  {&pb.ConfirmPasswordResetRequest{Token: "token", NewPassword: "correct horse"}, repository.ErrInvalidResetToken, codes.InvalidArgument},
+			"Database Error": {&pb.ConfirmPasswordResetRequest{Token: "token", NewPassword: "correct horse"}, errors.New("connection refused"), codes.Internal},
+			"Short Password": {&pb.ConfirmPasswordResetRequest{Token: "token", NewPassword: "short"}, nil, codes.InvalidArgument},
+			"Missing Token":  {&pb.ConfirmPasswordResetRequest{NewPassword: "correct horse"}, nil, codes.InvalidArgument},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectation
+			mockUserRepo.On("ResetPassword", ctx, "token", "correct horse").Return(tc.repoErr)
+
+			// Execute
+			_, err := svc.ConfirmPasswordReset(ctx, tc.request)
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(err), name)
+		}
+	})
+}
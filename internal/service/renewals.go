@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Loan methods
+func (s *LibraryService) RenewLoan(ctx context.Context, req *pb.RenewLoanRequest) (*pb.RenewLoanResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.BorrowId == "" {
+		return nil, status.Error(codes.InvalidArgument, "borrow id is required")
+	}
+
+	// Patrons may only renew their own loans; staff renew for anyone
+	if !isStaff(principal) {
+		borrowerID, err := s.bookRepo.GetBorrowerID(ctx, req.BorrowId)
+		if err != nil {
+			return nil, errorStatus(err, "failed to get borrow")
+		}
+		if borrowerID != principal.UserID {
+			return nil, status.Error(codes.PermissionDenied, "cannot renew books borrowed by another user")
+		}
+	}
+
+	// The circulation policy limits renewals and sets the new due date
+	renewal, err := s.bookRepo.RenewLoan(ctx, req.BorrowId, principal.UserID, s.rules)
+	if err != nil {
+		return nil, errorStatus(err, "failed to renew loan")
+	}
+
+	return &pb.RenewLoanResponse{
+		BorrowId:          renewal.BorrowID,
+		DueDate:           renewal.DueDate.Format(time.RFC3339),
+		RenewalsRemaining: int32(max(renewal.RenewalsRemaining, 0)),
+	}, nil
+}
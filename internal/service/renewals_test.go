@@ -0,0 +1,141 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/policy"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test the loan renewal method with mocks
+func TestLibraryService_RenewLoan(t *testing.T) {
+	patron := &auth.Principal{UserID: "patron-id", Roles: []string{auth.RolePatron}}
+	librarian := &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}}
+
+	t.Run("Successful Renewal", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service with its own circulation policy
+		rules := policy.NewEngine([]policy.Rule{{PatronType: policy.Any, ItemType: policy.Any, LoanDays: 7, MaxLoans: 5, MaxRenewals: 3}}, nil)
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithCirculationPolicy(rules))
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+		dueDate := time.Date(2030, 1, 15, 12, 0, 0, 0, time.UTC)
+
+		// Set up mock expectations
+		mockBookRepo.On("GetBorrowerID", ctx, "borrow-id-123").Return("patron-id", nil)
+		mockBookRepo.On("RenewLoan", ctx, "borrow-id-123", "patron-id", rules).
+			Return(&repository.Renewal{BorrowID: "borrow-id-123", DueDate: dueDate, RenewalCount: 1, RenewalsRemaining: 2}, nil)
+
+		// Execute
+		response, err := svc.RenewLoan(ctx, &pb.RenewLoanRequest{BorrowId: "borrow-id-123"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "borrow-id-123", response.BorrowId)
+		assert.Equal(t, dueDate.Format(time.RFC3339), response.DueDate)
+		assert.Equal(t, int32(2), response.RenewalsRemaining)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Renewing Another Patron's Loan Denied", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), patron)
+
+		// Set up mock expectation
+		mockBookRepo.On("GetBorrowerID", ctx, "borrow-id-123").Return("someone-else", nil)
+
+		// Execute
+		response, err := svc.RenewLoan(ctx, &pb.RenewLoanRequest{BorrowId: "borrow-id-123"})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockBookRepo.AssertNotCalled(t, "RenewLoan", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Refused Renewals", func(t *testing.T) {
+		cases := map[error]codes.Code{
+			repository.ErrLoanReturned: codes.FailedPrecondition,
+			policy.Violate(policy.ReasonMaxRenewals, "renewal limit of 2 reached"): codes.FailedPrecondition,
+			policy.Violate(policy.ReasonHoldsWaiting, "other patrons are waiting"): codes.FailedPrecondition,
+			errors.New("boom"): codes.Internal,
+		}
+
+		for repoErr, code := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Test data: staff renew without an ownership check
+			ctx := auth.NewContext(context.Background(), librarian)
+
+			// Set up mock expectation
+			mockBookRepo.On("RenewLoan", ctx, "borrow-id-123", "librarian-id", mock.Anything).Return(nil, repoErr)
+
+			// Execute
+			response, err := svc.RenewLoan(ctx, &pb.RenewLoanRequest{BorrowId: "borrow-id-123"})
+
+			// Verify
+			assert.Nil(t, response)
+			assert.Equal(t, code, status.Code(err), repoErr.Error())
+			mockBookRepo.AssertNotCalled(t, "GetBorrowerID", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("Violation Reason In Details", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Test data
+		ctx := auth.NewContext(context.Background(), librarian)
+
+		// Set up mock expectation
+		mockBookRepo.On("RenewLoan", ctx, "borrow-id-123", "librarian-id", mock.Anything).
+			Return(nil, policy.Violate(policy.ReasonOverdueBeyondGrace, "loan is overdue beyond the renewal grace period"))
+
+		// Execute
+		_, err := svc.RenewLoan(ctx, &pb.RenewLoanRequest{BorrowId: "borrow-id-123"})
+
+		// Verify
+		st := status.Convert(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		if assert.Len(t, st.Details(), 1) {
+			info := st.Details()[0].(*errdetails.ErrorInfo)
+			assert.Equal(t, policy.ReasonOverdueBeyondGrace, info.Reason)
+			assert.Equal(t, policy.Domain, info.Domain)
+		}
+	})
+}
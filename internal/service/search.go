@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Catalogue search methods
+func (s *LibraryService) SearchBooks(ctx context.Context, req *pb.SearchBooksRequest) (*pb.SearchBooksResponse, error) {
+	search := repository.BookSearch{
+		Query:         strings.TrimSpace(req.Query),
+		AvailableOnly: req.AvailableOnly,
+		Author:        strings.TrimSpace(req.Author),
+		YearFrom:      req.PublicationYearFrom,
+		YearTo:        req.PublicationYearTo,
+		Language:      strings.TrimSpace(req.Language),
+	}
+
+	if search.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if search.YearFrom < 0 || search.YearTo < 0 {
+		return nil, status.Error(codes.InvalidArgument, "publication years cannot be negative")
+	}
+	if search.YearTo > 0 && search.YearFrom > search.YearTo {
+		return nil, status.Error(codes.InvalidArgument, "publication year range is empty")
+	}
+
+	pageSize := int32(DefaultPageSize)
+	if req.PageSize > 0 {
+		pageSize = min(req.PageSize, MaxPageSize)
+	}
+
+	// Ranks only compare within one search, so tokens are tied to the search they came from
+	scope := searchScope(search)
+	var after *pagination.Cursor
+	if req.PageToken != "" {
+		cursor, err := s.pageTokens.Decode(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if cursor.Scope != scope {
+			return nil, status.Error(codes.InvalidArgument, "page token was issued for a different search")
+		}
+		after = &cursor
+	}
+
+	// Fetch one extra result to learn whether there is a next page
+	results, err := s.bookRepo.Search(ctx, search, pageSize+1, after)
+	if err != nil {
+		return nil, errorStatus(err, "failed to search books")
+	}
+
+	response := &pb.SearchBooksResponse{Results: results}
+	if len(results) > int(pageSize) {
+		response.Results = results[:pageSize]
+		last := response.Results[pageSize-1]
+		response.NextPageToken = s.pageTokens.Encode(pagination.Cursor{
+			Key:   repository.SearchKey(last),
+			ID:    last.Book.Id,
+			Scope: scope,
+		})
+	}
+
+	return response, nil
+}
+
+// searchScope fingerprints a search for its page tokens
+func searchScope(search repository.BookSearch) string {
+	encoded, _ := json.Marshal(search)
+	sum := sha256.Sum256(encoded)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
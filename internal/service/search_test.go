@@ -0,0 +1,116 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test SearchBooks with mocks
+func TestLibraryService_SearchBooks(t *testing.T) {
+	codec := pagination.NewCodec([]byte("test-page-token-key"))
+	ctx := context.Background()
+
+	t.Run("Pages Through Results", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithPageTokens(codec))
+
+		// Set up mock expectations: one more result than the page size means there is a next page
+		search := repository.BookSearch{Query: "dune", AvailableOnly: true, Language: "en"}
+		firstPage := []*pb.BookSearchResult{
+			{Book: &pb.Book{Id: "book-1"}, Rank: 0.5, Snippet: "<mark>Dune</mark>"},
+			{Book: &pb.Book{Id: "book-2"}, Rank: 0.25},
+			{Book: &pb.Book{Id: "book-3"}, Rank: 0.25},
+		}
+		mockBookRepo.On("Search", ctx, search, int32(3), (*pagination.Cursor)(nil)).Return(firstPage, nil).Once()
+		mockBookRepo.On("Search", ctx, search, int32(3), mock.MatchedBy(func(cursor *pagination.Cursor) bool {
+			return cursor.Key == "0.25" && cursor.ID == "book-2"
+		})).Return(firstPage[2:], nil).Once()
+
+		// Execute the first page
+		request := &pb.SearchBooksRequest{Query: " dune ", AvailableOnly: true, Language: "en", PageSize: 2}
+		response, err := svc.SearchBooks(ctx, request)
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Len(t, response.Results, 2)
+		assert.Equal(t, "<mark>Dune</mark>", response.Results[0].Snippet)
+		assert.NotEmpty(t, response.NextPageToken)
+
+		// Execute the last page
+		request.PageToken = response.NextPageToken
+		response, err = svc.SearchBooks(ctx, request)
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "book-3", response.Results[0].Book.Id)
+		assert.Empty(t, response.NextPageToken)
+
+		// Verify mock was called as expected
+		mockBookRepo.AssertExpectations(t)
+	})
+
+	t.Run("Page Token Of Another Search", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithPageTokens(codec))
+
+		// Set up mock expectation
+		results := []*pb.BookSearchResult{{Book: &pb.Book{Id: "book-1"}, Rank: 0.5}, {Book: &pb.Book{Id: "book-2"}, Rank: 0.25}}
+		mockBookRepo.On("Search", ctx, repository.BookSearch{Query: "dune"}, int32(2), (*pagination.Cursor)(nil)).Return(results, nil).Once()
+
+		// Execute
+		response, err := svc.SearchBooks(ctx, &pb.SearchBooksRequest{Query: "dune", PageSize: 1})
+		assert.NoError(t, err)
+		response, err = svc.SearchBooks(ctx, &pb.SearchBooksRequest{Query: "foundation", PageSize: 1, PageToken: response.NextPageToken})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mockBookRepo.AssertNumberOfCalls(t, "Search", 1)
+	})
+
+	t.Run("Invalid Searches", func(t *testing.T) {
+		cases := map[string]*pb.SearchBooksRequest{
+			"Empty Query":        {Query: "  "},
+			"Negative Year":      {Query: "dune", PublicationYearFrom: -1},
+			"Empty Year Range":   {Query: "dune", PublicationYearFrom: 1970, PublicationYearTo: 1960},
+			"Forged Page Token":  {Query: "dune", PageToken: pagination.NewCodec([]byte("another-key")).Encode(pagination.Cursor{Key: "0.5", ID: "book-1"})},
+			"Garbled Page Token": {Query: "dune", PageToken: "garbled"},
+		}
+
+		for name, request := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithPageTokens(codec))
+
+			// Execute
+			response, err := svc.SearchBooks(ctx, request)
+
+			// Verify
+			assert.Nil(t, response, name)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+			mockBookRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+}
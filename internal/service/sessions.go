@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/repository"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Session methods
+func (s *LibraryService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	refreshToken := strings.TrimSpace(req.RefreshToken)
+	if refreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	if s.tokens == nil {
+		return nil, status.Error(codes.Internal, "token issuer is not configured")
+	}
+
+	newRefreshToken, err := newUserToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate refresh token: %v", err)
+	}
+	refreshExpiresAt := time.Now().Add(s.refreshTokenTTL)
+
+	user, err := s.userRepo.RotateSession(ctx, refreshToken, newRefreshToken, refreshExpiresAt)
+	if err != nil {
+		return nil, errorStatus(err, "failed to refresh session")
+	}
+
+	// Roles are read afresh, so grants and revocations apply from the next refresh
+	token, expiresAt, err := s.tokens.Issue(user.Id, repository.RoleNames(user.Roles))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue token: %v", err)
+	}
+
+	return &pb.RefreshTokenResponse{
+		User:             user,
+		Token:            token,
+		ExpiresAt:        expiresAt.Format(time.RFC3339),
+		RefreshToken:     newRefreshToken,
+		RefreshExpiresAt: refreshExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *LibraryService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	refreshToken := strings.TrimSpace(req.RefreshToken)
+	if refreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	if err := s.userRepo.RevokeSession(ctx, refreshToken, req.AllSessions); err != nil {
+		return nil, errorStatus(err, "failed to log out")
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
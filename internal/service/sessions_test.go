@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test RefreshToken and Logout with mocks
+func TestLibraryService_Sessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Refresh Rotates Token", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+		tokens := newTestTokenManager(t)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo,
+			service.WithTokenIssuer(tokens),
+			service.WithRefreshTokenTTL(24*time.Hour))
+
+		// Set up mock expectation: the new refresh token expires after the configured TTL
+		var newRefreshToken string
+		user := &pb.User{Id: "user-id-123", Roles: []pb.Role{pb.Role_ROLE_PATRON}}
+		mockUserRepo.On("RotateSession", ctx, "refresh-token", mock.AnythingOfType("string"), mock.MatchedBy(func(expiresAt time.Time) bool {
+			return time.Until(expiresAt) > 23*time.Hour && time.Until(expiresAt) <= 24*time.Hour
+		})).Run(func(args mock.Arguments) {
+			newRefreshToken = args.String(2)
+		}).Return(user, nil)
+
+		// Execute
+		response, err := svc.RefreshToken(ctx, &pb.RefreshTokenRequest{RefreshToken: " refresh-token "})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Len(t, newRefreshToken, 43)
+		assert.Equal(t, newRefreshToken, response.RefreshToken)
+		assert.NotEmpty(t, response.RefreshExpiresAt)
+
+		// The new access token carries the user's current roles
+		claims, err := tokens.Verify(response.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, "user-id-123", claims.UserID())
+		assert.Equal(t, []string{auth.RolePatron}, claims.Roles)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Refresh Refused", func(t *testing.T) {
+		cases := map[string]struct {
+			request *pb.RefreshTokenRequest
+			repoErr error
+			code    codes.Code
+		}{
+			"Reused Token":  {&pb.RefreshTokenRequest{RefreshToken: "refresh-token"}, repository.ErrRefreshTokenReused, codes.Unauthenticated},
+			"Invalid Token": {&pb.RefreshTokenRequest{RefreshToken: "refresh-token"}, repository.ErrInvalidRefreshToken, codes.Unauthenticated},
+			"Missing Token": {&pb.RefreshTokenRequest{}, nil, codes.InvalidArgument},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo, service.WithTokenIssuer(newTestTokenManager(t)))
+
+			// Set up mock expectation
+			mockUserRepo.On("RotateSession", ctx, "refresh-token", mock.Anything, mock.Anything).Return(nil, tc.repoErr)
+
+			// Execute
+			response, err := svc.RefreshToken(ctx, tc.request)
+
+			// Verify
+			assert.Nil(t, response, name)
+			assert.Equal(t, tc.code, status.Code(err), name)
+		}
+	})
+
+	t.Run("Logout", func(t *testing.T) {
+		cases := map[string]struct {
+			request *pb.LogoutRequest
+			repoErr error
+			code    codes.Code
+		}{
+			"This Session":  {&pb.LogoutRequest{RefreshToken: "refresh-token"}, nil, codes.OK},
+			"All Sessions":  {&pb.LogoutRequest{RefreshToken: "refresh-token", AllSessions: true}, nil, codes.OK},
+			"Invalid Token": {&pb.LogoutRequest{RefreshToken: "refresh-token"}, repository.ErrInvalidRefreshToken, codes.Unauthenticated},
+			"Missing Token": {&pb.LogoutRequest{AllSessions: true}, nil, codes.InvalidArgument},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectation
+			mockUserRepo.On("RevokeSession", ctx, "refresh-token", tc.request.AllSessions).Return(tc.repoErr)
+
+			// Execute
+			_, err := svc.Logout(ctx, tc.request)
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(err), name)
+		}
+	})
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"library-management-service/internal/auth"
+	"library-management-service/internal/pagination"
+	pb "library-management-service/proto/library/v1"
+)
+
+// emailPattern matches the email addresses users may register with
+var emailPattern = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+
+// minPasswordLength is the length passwords must have at least
+const minPasswordLength = 8
+
+// usersScope marks the page tokens of ListUsers
+const usersScope = "users"
+
+// User profile methods
+func (s *LibraryService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	userID, err := profileOwner(ctx, req.UserId, "view")
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errorStatus(err, "failed to get user")
+	}
+
+	return &pb.GetUserResponse{User: user}, nil
+}
+
+func (s *LibraryService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, email := strings.TrimSpace(req.Name), strings.TrimSpace(req.Email)
+
+	// Password resets are mailed to the email, so whoever changes it can take the account over:
+	// only admins change the email of another user, and librarians do not edit admins at all
+	userID := req.UserId
+	if userID == "" {
+		userID = principal.UserID
+	}
+	otherUser := userID != principal.UserID && !principal.HasRole(auth.RoleAdmin)
+	if otherUser && !isStaff(principal) {
+		return nil, status.Error(codes.PermissionDenied, "cannot update another user")
+	}
+	if otherUser && email != "" {
+		return nil, status.Error(codes.PermissionDenied, "only admins can change the email of another user")
+	}
+
+	if name == "" && email == "" {
+		return nil, status.Error(codes.InvalidArgument, "name or email is required")
+	}
+	if len(name) > maxNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be at most %d characters", maxNameLength)
+	}
+	if email != "" && !emailPattern.MatchString(email) {
+		return nil, status.Error(codes.InvalidArgument, "invalid email format")
+	}
+
+	if otherUser {
+		target, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return nil, errorStatus(err, "failed to get user")
+		}
+		if slices.Contains(target.Roles, pb.Role_ROLE_ADMIN) {
+			return nil, status.Error(codes.PermissionDenied, "only admins can update an admin")
+		}
+	}
+
+	user, err := s.userRepo.UpdateProfile(ctx, userID, name, email)
+	if err != nil {
+		return nil, errorStatus(err, "failed to update user")
+	}
+
+	// A new email is pending until it is verified, like that of a new user, and failing to send
+	// the verification does not undo the change either
+	if email != "" && !user.EmailVerified && s.mailer != nil {
+		if err := s.sendVerificationEmail(ctx, user.Id); err != nil {
+			log.Printf("Failed to send verification email to user %s: %v", user.Id, err)
+		}
+	}
+
+	return &pb.UpdateUserResponse{User: user}, nil
+}
+
+func (s *LibraryService) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	// Users only ever change their own password
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CurrentPassword == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "current and new password are required")
+	}
+	if len(req.NewPassword) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+
+	if err := s.userRepo.ChangePassword(ctx, principal.UserID, req.CurrentPassword, req.NewPassword); err != nil {
+		return nil, errorStatus(err, "failed to change password")
+	}
+
+	return &pb.ChangePasswordResponse{}, nil
+}
+
+func (s *LibraryService) DeactivateUser(ctx context.Context, req *pb.DeactivateUserRequest) (*pb.DeactivateUserResponse, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users may close their own account; only admins close anyone else's
+	if req.UserId == "" {
+		req.UserId = principal.UserID
+	} else if req.UserId != principal.UserID && !principal.HasRole(auth.RoleAdmin) {
+		return nil, status.Error(codes.PermissionDenied, "cannot deactivate another user")
+	}
+
+	// Keep at least one admin able to manage users
+	if req.UserId == principal.UserID && principal.HasRole(auth.RoleAdmin) {
+		return nil, status.Error(codes.FailedPrecondition, "admins cannot deactivate themselves")
+	}
+
+	user, err := s.userRepo.Deactivate(ctx, req.UserId)
+	if err != nil {
+		return nil, errorStatus(err, "failed to deactivate user")
+	}
+
+	return &pb.DeactivateUserResponse{User: user}, nil
+}
+
+func (s *LibraryService) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	if _, err := authorize(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	pageSize := int32(DefaultPageSize)
+	if req.PageSize > 0 {
+		pageSize = min(req.PageSize, MaxPageSize)
+	}
+
+	var after *pagination.Cursor
+	if req.PageToken != "" {
+		cursor, err := s.pageTokens.Decode(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if cursor.Scope != usersScope {
+			return nil, status.Error(codes.InvalidArgument, "page token was not issued by ListUsers")
+		}
+		after = &cursor
+	}
+
+	// Fetch one extra user to learn whether there is a next page
+	users, err := s.userRepo.List(ctx, strings.TrimSpace(req.Query), req.IncludeDeactivated, pageSize+1, after)
+	if err != nil {
+		return nil, errorStatus(err, "failed to list users")
+	}
+
+	response := &pb.ListUsersResponse{Users: users}
+	if len(users) > int(pageSize) {
+		response.Users = users[:pageSize]
+		last := response.Users[pageSize-1]
+		response.NextPageToken = s.pageTokens.Encode(pagination.Cursor{Key: last.Name, ID: last.Id, Scope: usersScope})
+	}
+
+	return response, nil
+}
+
+// profileOwner returns the id of the user whose profile the caller wants to act on: the caller's
+// own by default. Only staff may act on the profiles of other users.
+func profileOwner(ctx context.Context, userID, action string) (string, error) {
+	principal, err := authorize(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if userID == "" {
+		return principal.UserID, nil
+	}
+	if userID != principal.UserID && !isStaff(principal) {
+		return "", status.Errorf(codes.PermissionDenied, "cannot %s another user", action)
+	}
+	return userID, nil
+}
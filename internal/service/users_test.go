@@ -0,0 +1,274 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"library-management-service/internal/auth"
+	"library-management-service/internal/mocks"
+	"library-management-service/internal/pagination"
+	"library-management-service/internal/repository"
+	"library-management-service/internal/service"
+	pb "library-management-service/proto/library/v1"
+)
+
+// Test the user profile methods with mocks
+func TestLibraryService_UserProfiles(t *testing.T) {
+	patronCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "patron-id", Roles: []string{auth.RolePatron}})
+	adminCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "admin-id", Roles: []string{auth.RoleAdmin}})
+	librarianCtx := auth.NewContext(context.Background(), &auth.Principal{UserID: "librarian-id", Roles: []string{auth.RoleLibrarian}})
+
+	t.Run("Get Own Profile", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectation
+		mockUserRepo.On("GetByID", patronCtx, "patron-id").Return(&pb.User{Id: "patron-id", Active: true}, nil)
+
+		// Execute
+		response, err := svc.GetUser(patronCtx, &pb.GetUserRequest{})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "patron-id", response.User.Id)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Update Email", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectation
+		mockUserRepo.On("UpdateProfile", patronCtx, "patron-id", "", "new@example.com").
+			Return(&pb.User{Id: "patron-id", Email: "new@example.com"}, nil)
+
+		// Execute
+		response, err := svc.UpdateUser(patronCtx, &pb.UpdateUserRequest{Email: " new@example.com "})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.False(t, response.User.EmailVerified)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Librarian Renames Patron", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectations
+		mockUserRepo.On("GetByID", librarianCtx, "patron-id").Return(&pb.User{Id: "patron-id", Roles: []pb.Role{pb.Role_ROLE_PATRON}}, nil)
+		mockUserRepo.On("UpdateProfile", librarianCtx, "patron-id", "Ada Lovelace", "").
+			Return(&pb.User{Id: "patron-id", Name: "Ada Lovelace"}, nil)
+
+		// Execute
+		response, err := svc.UpdateUser(librarianCtx, &pb.UpdateUserRequest{UserId: "patron-id", Name: "Ada Lovelace"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "Ada Lovelace", response.User.Name)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Librarian Changes Email Denied", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Execute
+		response, err := svc.UpdateUser(librarianCtx, &pb.UpdateUserRequest{UserId: "patron-id", Email: "librarian@example.com"})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// The email is left alone
+		mockUserRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Librarian Renames Admin Denied", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectation
+		mockUserRepo.On("GetByID", librarianCtx, "admin-id").Return(&pb.User{Id: "admin-id", Roles: []pb.Role{pb.Role_ROLE_PATRON, pb.Role_ROLE_ADMIN}}, nil)
+
+		// Execute
+		response, err := svc.UpdateUser(librarianCtx, &pb.UpdateUserRequest{UserId: "admin-id", Name: "Mallory"})
+
+		// Verify
+		assert.Nil(t, response)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		mockUserRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Admin Changes Email", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectation
+		mockUserRepo.On("UpdateProfile", adminCtx, "patron-id", "", "new@example.com").
+			Return(&pb.User{Id: "patron-id", Email: "new@example.com"}, nil)
+
+		// Execute
+		response, err := svc.UpdateUser(adminCtx, &pb.UpdateUserRequest{UserId: "patron-id", Email: "new@example.com"})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Equal(t, "new@example.com", response.User.Email)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("Refused", func(t *testing.T) {
+		cases := map[string]struct {
+			call func(svc *service.LibraryService) error
+			code codes.Code
+		}{
+			"Get Another User": {func(svc *service.LibraryService) error {
+				_, err := svc.GetUser(patronCtx, &pb.GetUserRequest{UserId: "other-id"})
+				return err
+			}, codes.PermissionDenied},
+			"Update Nothing": {func(svc *service.LibraryService) error {
+				_, err := svc.UpdateUser(patronCtx, &pb.UpdateUserRequest{Name: " "})
+				return err
+			}, codes.InvalidArgument},
+			"Update Another User": {func(svc *service.LibraryService) error {
+				_, err := svc.UpdateUser(patronCtx, &pb.UpdateUserRequest{UserId: "other-id", Name: "Mallory"})
+				return err
+			}, codes.PermissionDenied},
+			"Update Invalid Email": {func(svc *service.LibraryService) error {
+				_, err := svc.UpdateUser(patronCtx, &pb.UpdateUserRequest{Email: "not-an-email"})
+				return err
+			}, codes.InvalidArgument},
+			"Short Password": {func(svc *service.LibraryService) error {
+				_, err := svc.ChangePassword(patronCtx, &pb.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "short"})
+				return err
+			}, codes.InvalidArgument},
+			"Deactivate Another User": {func(svc *service.LibraryService) error {
+				_, err := svc.DeactivateUser(patronCtx, &pb.DeactivateUserRequest{UserId: "other-id"})
+				return err
+			}, codes.PermissionDenied},
+			"Deactivate Own Admin": {func(svc *service.LibraryService) error {
+				_, err := svc.DeactivateUser(adminCtx, &pb.DeactivateUserRequest{})
+				return err
+			}, codes.FailedPrecondition},
+			"List As Patron": {func(svc *service.LibraryService) error {
+				_, err := svc.ListUsers(patronCtx, &pb.ListUsersRequest{})
+				return err
+			}, codes.PermissionDenied},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Execute
+			err := tc.call(svc)
+
+			// Verify: nothing reaches the repository
+			assert.Equal(t, tc.code, status.Code(err), name)
+			assert.Empty(t, mockUserRepo.Calls, name)
+		}
+	})
+
+	t.Run("Repository Errors", func(t *testing.T) {
+		cases := map[string]struct {
+			repoErr error
+			code    codes.Code
+		}{
+			"Email Taken":    {repository.ErrDuplicateEmail, codes.AlreadyExists},
+			"Wrong Password": {repository.ErrWrongPassword, codes.PermissionDenied},
+			"Deactivated":    {repository.ErrUserNotFound, codes.NotFound},
+		}
+
+		for name, tc := range cases {
+			// Create mock repositories
+			mockUserRepo := new(mocks.MockUserRepository)
+			mockBookRepo := new(mocks.MockBookRepository)
+
+			// Create service
+			svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+			// Set up mock expectations
+			mockUserRepo.On("UpdateProfile", patronCtx, "patron-id", mock.Anything, mock.Anything).Return(nil, tc.repoErr)
+			mockUserRepo.On("ChangePassword", patronCtx, "patron-id", mock.Anything, mock.Anything).Return(tc.repoErr)
+
+			// Execute
+			_, updateErr := svc.UpdateUser(patronCtx, &pb.UpdateUserRequest{Email: "taken@example.com"})
+			_, changeErr := svc.ChangePassword(patronCtx, &pb.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "correct horse"})
+
+			// Verify
+			assert.Equal(t, tc.code, status.Code(updateErr), name)
+			assert.Equal(t, tc.code, status.Code(changeErr), name)
+		}
+	})
+
+	t.Run("List Users Pages", func(t *testing.T) {
+		// Create mock repositories
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockBookRepo := new(mocks.MockBookRepository)
+
+		// Create service
+		svc := service.NewLibraryService(mockUserRepo, mockBookRepo)
+
+		// Set up mock expectations: one user more than the page size is returned
+		users := []*pb.User{{Id: "user-id-1", Name: "Ada"}, {Id: "user-id-2", Name: "Grace"}}
+		mockUserRepo.On("List", adminCtx, "example.com", true, int32(2), (*pagination.Cursor)(nil)).Return(users, nil)
+		mockUserRepo.On("List", adminCtx, "example.com", true, int32(2), &pagination.Cursor{Key: "Ada", ID: "user-id-1", Scope: "users"}).
+			Return(users[1:], nil)
+
+		// Execute
+		first, err := svc.ListUsers(adminCtx, &pb.ListUsersRequest{Query: "example.com", IncludeDeactivated: true, PageSize: 1})
+		assert.NoError(t, err)
+		second, err := svc.ListUsers(adminCtx, &pb.ListUsersRequest{Query: "example.com", IncludeDeactivated: true, PageSize: 1, PageToken: first.NextPageToken})
+
+		// Verify
+		assert.NoError(t, err)
+		assert.Len(t, first.Users, 1)
+		assert.NotEmpty(t, first.NextPageToken)
+		assert.Equal(t, "user-id-2", second.Users[0].Id)
+		assert.Empty(t, second.NextPageToken)
+
+		// Verify mock was called as expected
+		mockUserRepo.AssertExpectations(t)
+	})
+}